@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/JohnPlummer/reddit-client/reddit"
@@ -112,25 +113,34 @@ func demonstrateInterceptors(auth *reddit.Auth) {
 		fmt.Printf("Fetched %d posts with request tracing\n", len(posts))
 	}
 
+	// A request ID attached to the context is sent on every attempt of the call, including
+	// retries, and the interceptor keeps it instead of generating a new one
+	ctx := reddit.ContextWithRequestID(context.Background(), "webdev-crawl-1")
+	posts, err = subreddit3.GetPosts(ctx, reddit.WithSubredditLimit(1))
+	if err != nil {
+		log.Printf("Error fetching posts: %v", err)
+	} else {
+		fmt.Printf("Fetched %d posts with request tracing\n", len(posts))
+	}
+
 	fmt.Println()
 
 	// 4. Performance Monitoring
 	fmt.Println("4. Performance Monitoring:")
+	// Start times are keyed by the request, which the response carries back, rather than sent
+	// to Reddit in a header
+	var startTimes sync.Map
 	client4, err := reddit.NewClient(auth,
 		reddit.WithRequestInterceptor(func(req *http.Request) error {
 			startTime := time.Now()
-			// Store start time in context (in real code, you'd use proper context)
-			req.Header.Set("X-Start-Time", startTime.Format(time.RFC3339Nano))
+			startTimes.Store(req, startTime)
 			fmt.Printf("Request started at: %s for %s\n", startTime.Format("15:04:05.000"), req.URL.Path)
 			return nil
 		}),
 		reddit.WithResponseInterceptor(func(resp *http.Response) error {
-			if resp.Request != nil {
-				startTimeStr := resp.Request.Header.Get("X-Start-Time")
-				if startTime, err := time.Parse(time.RFC3339Nano, startTimeStr); err == nil {
-					duration := time.Since(startTime)
-					fmt.Printf("Request completed in: %v (Status: %d)\n", duration, resp.StatusCode)
-				}
+			if startTime, ok := startTimes.LoadAndDelete(resp.Request); ok {
+				duration := time.Since(startTime.(time.Time))
+				fmt.Printf("Request completed in: %v (Status: %d)\n", duration, resp.StatusCode)
 			}
 			return nil
 		}),
@@ -0,0 +1,70 @@
+package reddit
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// actionRequester is implemented by the Client and used by posts, comments and subreddits to
+// perform write actions on behalf of the authenticated user, and for reads that commentGetter does
+// not cover, such as /duplicates. Actions go through the same rate limiting, retry and circuit
+// breaker handling as reads.
+type actionRequester interface {
+	requestJSON(ctx context.Context, method, endpoint string, form url.Values, result any) error
+}
+
+var _ actionRequester = (*Client)(nil)
+
+// vote casts a vote on the thing with the given fullname via /api/vote
+func vote(ctx context.Context, client actionRequester, fullname string, dir int) error {
+	if dir < -1 || dir > 1 {
+		return fmt.Errorf("%w: %d", ErrInvalidVote, dir)
+	}
+
+	form := url.Values{}
+	form.Set("id", fullname)
+	form.Set("dir", strconv.Itoa(dir))
+
+	if err := client.requestJSON(ctx, "POST", "/api/vote", form, nil); err != nil {
+		return fmt.Errorf("voting on %s failed: %w", fullname, err)
+	}
+	return nil
+}
+
+// setSubscription subscribes the authenticated user to, or unsubscribes them from, the named
+// subreddit via /api/subscribe. A combined name such as golang+rust covers each subreddit in it.
+func setSubscription(ctx context.Context, client actionRequester, subreddit string, subscribe bool) error {
+	if err := validateSubredditName(subreddit); err != nil {
+		return err
+	}
+
+	action := "unsub"
+	if subscribe {
+		action = "sub"
+	}
+
+	form := url.Values{}
+	form.Set("action", action)
+	// sr_name takes a comma-separated list rather than the "+" of multireddit paths
+	form.Set("sr_name", strings.ReplaceAll(subreddit, "+", ","))
+
+	if err := client.requestJSON(ctx, "POST", "/api/subscribe", form, nil); err != nil {
+		return fmt.Errorf("%s r/%s failed: %w", action, subreddit, err)
+	}
+	return nil
+}
+
+// thingAction posts the fullname of a post or comment to an endpoint that takes only an id,
+// such as /api/save or /api/hide
+func thingAction(ctx context.Context, client actionRequester, endpoint, fullname string) error {
+	form := url.Values{}
+	form.Set("id", fullname)
+
+	if err := client.requestJSON(ctx, "POST", endpoint, form, nil); err != nil {
+		return fmt.Errorf("%s on %s failed: %w", endpoint, fullname, err)
+	}
+	return nil
+}
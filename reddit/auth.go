@@ -2,37 +2,71 @@ package reddit
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 const (
-	tokenURL      = "https://www.reddit.com/api/v1/access_token"
-	tokenLifetime = time.Hour // Reddit tokens typically last 1 hour
+	tokenURL                = "https://www.reddit.com/api/v1/access_token"
+	tokenLifetime           = time.Hour   // Reddit tokens typically last 1 hour
+	defaultRefreshThreshold = time.Minute // Refresh tokens this long before they expire
 )
 
 // TokenResponse represents the Reddit OAuth token response
 type TokenResponse struct {
-	AccessToken string `json:"access_token"`
-	TokenType   string `json:"token_type"`
-	ExpiresIn   int    `json:"expires_in"`
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
 }
 
-// Auth represents the authentication configuration
+// TokenUpdateCallback is called with the new access token and its expiry after each successful authentication
+type TokenUpdateCallback func(accessToken string, expiry time.Time)
+
+// TokenStore persists access tokens between processes, so short-lived programs such as serverless
+// functions can reuse a valid token instead of authenticating on every start.
+// Load returns an empty token and a nil error when nothing has been stored yet.
+type TokenStore interface {
+	Load() (token string, expiry time.Time, err error)
+	Save(token string, expiry time.Time) error
+}
+
+// Auth represents the authentication configuration.
+// An Auth is safe for concurrent use; Token and ExpiresAt are updated under a lock when the token
+// is refreshed, so they must not be read or modified directly once the Auth is shared with a
+// Client. Use TokenExpiry to read the expiry of an Auth in use.
 type Auth struct {
-	ClientID     string
-	ClientSecret string
-	Token        string
-	ExpiresAt    time.Time
-	userAgent    string
-	client       *http.Client
-	timeout      time.Duration
+	ClientID            string
+	ClientSecret        string
+	Token               string
+	ExpiresAt           time.Time
+	mu                  sync.RWMutex // guards Token, ExpiresAt and refreshToken
+	refreshMu           sync.Mutex   // serialises token refreshes so concurrent requests authenticate once
+	maxTokenWaiters     int          // bound on callers queued for a refresh, 0 for unbounded
+	tokenWaiters        atomic.Int32 // callers currently refreshing or queued to refresh
+	lastAuthErr         error        // error from the most recent failed refresh, guarded by mu
+	userAgent           string
+	client              *http.Client
+	timeout             time.Duration
+	refreshThreshold    time.Duration
+	refreshThresholdSet bool   // set by WithAuthRefreshThreshold, so an explicit 0 is kept
+	refreshToken        string // set for user context authentication via NewAuthWithRefreshToken
+	onTokenUpdate       TokenUpdateCallback
+	tokenStore          TokenStore
+	scopes              []string // sent with each token request, set by WithAuthScopes
+	optionErrors        []error  // validation errors recorded by options, reported by NewAuth
 }
 
 // requestJSON performs an HTTP request and decodes the JSON response into the provided result
@@ -50,13 +84,13 @@ func (a *Auth) requestJSON(ctx context.Context, method, url, contentType string,
 
 	resp, err := a.client.Do(req)
 	if err != nil {
-		return fmt.Errorf("auth.requestJSON: making request failed: %w", err)
+		return fmt.Errorf("auth.requestJSON: making request failed: %w", wrapAPIError(0, "network error", err))
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("auth.requestJSON: reading response body failed: %w", err)
+		return fmt.Errorf("auth.requestJSON: reading response body failed: %w", wrapAPIError(resp.StatusCode, "reading response failed", err))
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -64,23 +98,80 @@ func (a *Auth) requestJSON(ctx context.Context, method, url, contentType string,
 	}
 
 	if err := json.Unmarshal(respBody, result); err != nil {
-		return fmt.Errorf("auth.requestJSON: parsing JSON response failed for %s %s: %w", method, url, err)
+		return fmt.Errorf("auth.requestJSON: parsing JSON response failed for %s %s: %w", method, url, wrapAPIError(resp.StatusCode, "decoding response failed", err))
 	}
 
 	return nil
 }
 
-// IsTokenExpired checks if the current token is expired or about to expire
+// IsTokenExpired checks if the current token is expired or about to expire.
+// A token is considered about to expire when less than the refresh threshold
+// (see WithAuthRefreshThreshold) remains before ExpiresAt.
 func (a *Auth) IsTokenExpired() bool {
-	return time.Now().Add(time.Minute).After(a.ExpiresAt)
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return time.Now().Add(a.refreshWindow()).After(a.ExpiresAt)
 }
 
-// Authenticate with app-only authentication (client credentials flow)
+// TokenExpiry returns when the current access token expires, read under the token lock.
+// It is the zero time before the first successful authentication.
+func (a *Auth) TokenExpiry() time.Time {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.ExpiresAt
+}
+
+// refreshWindow returns how long before expiry the token is refreshed, falling back to the
+// default for an Auth built without NewAuth or WithAuthRefreshThreshold
+func (a *Auth) refreshWindow() time.Duration {
+	if a.refreshThreshold == 0 && !a.refreshThresholdSet {
+		return defaultRefreshThreshold
+	}
+	return a.refreshThreshold
+}
+
+// cacheIdentity identifies whose responses are fetched with this Auth, so a cache shared between
+// clients never serves one user's responses to another: the client ID for app-only access, plus a
+// hash of the refresh token when acting on behalf of a user. The token itself never appears in it.
+func (a *Auth) cacheIdentity() string {
+	a.mu.RLock()
+	refreshToken := a.refreshToken
+	a.mu.RUnlock()
+
+	if refreshToken == "" {
+		return a.ClientID
+	}
+	sum := sha256.Sum256([]byte(refreshToken))
+	return a.ClientID + ":" + hex.EncodeToString(sum[:8])
+}
+
+// accessToken returns the current access token
+func (a *Auth) accessToken() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.Token
+}
+
+// Authenticate obtains a new access token. It uses app-only authentication (client credentials flow)
+// unless the Auth was created with NewAuthWithRefreshToken, in which case the refresh token is
+// exchanged for an access token acting on behalf of the user.
 func (a *Auth) Authenticate(ctx context.Context) error {
 	slog.InfoContext(ctx, "authenticating with Reddit")
 
+	a.mu.RLock()
+	refreshToken := a.refreshToken
+	a.mu.RUnlock()
+
 	data := url.Values{}
-	data.Set("grant_type", "client_credentials")
+	if refreshToken != "" {
+		data.Set("grant_type", "refresh_token")
+		data.Set("refresh_token", refreshToken)
+	} else {
+		data.Set("grant_type", "client_credentials")
+	}
+	if len(a.scopes) > 0 {
+		data.Set("scope", strings.Join(a.scopes, " "))
+	}
 
 	var tokenResp TokenResponse
 	if err := a.requestJSON(ctx, "POST", tokenURL, "application/x-www-form-urlencoded", strings.NewReader(data.Encode()), &tokenResp); err != nil {
@@ -92,24 +183,76 @@ func (a *Auth) Authenticate(ctx context.Context) error {
 		return fmt.Errorf("auth.Authenticate: no access token in response")
 	}
 
+	expiresAt := time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+
+	a.mu.Lock()
 	a.Token = tokenResp.AccessToken
-	a.ExpiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	a.ExpiresAt = expiresAt
+	// Reddit may rotate the refresh token
+	if a.refreshToken != "" && tokenResp.RefreshToken != "" {
+		a.refreshToken = tokenResp.RefreshToken
+	}
+	a.mu.Unlock()
 
 	slog.DebugContext(ctx, "authentication successful",
 		"expires_in", tokenResp.ExpiresIn,
-		"expires_at", a.ExpiresAt,
+		"expires_at", expiresAt,
 	)
 
+	// A token that can't be stored is still valid for this process
+	if a.tokenStore != nil {
+		if err := a.tokenStore.Save(tokenResp.AccessToken, expiresAt); err != nil {
+			slog.WarnContext(ctx, "failed to save token to token store", "error", err)
+		}
+	}
+
+	if a.onTokenUpdate != nil {
+		a.onTokenUpdate(tokenResp.AccessToken, expiresAt)
+	}
+
 	return nil
 }
 
-// EnsureValidToken checks if the token is expired and refreshes if necessary
+// EnsureValidToken checks if the token is expired or about to expire and refreshes it if necessary.
+// Refreshing proactively avoids sending requests with a token that expires mid-flight.
+// Concurrent callers share a single refresh rather than each requesting a new token.
 func (a *Auth) EnsureValidToken(ctx context.Context) error {
-	if a.IsTokenExpired() {
-		slog.DebugContext(ctx, "token expired, refreshing")
-		return a.Authenticate(ctx)
+	if !a.IsTokenExpired() {
+		return nil
 	}
-	return nil
+
+	// Fail fast rather than queue behind a refresh when too many callers are already waiting,
+	// so an unavailable auth endpoint can't block an unbounded number of goroutines
+	if a.maxTokenWaiters > 0 {
+		if int(a.tokenWaiters.Add(1)) > a.maxTokenWaiters {
+			a.tokenWaiters.Add(-1)
+			a.mu.RLock()
+			lastErr := a.lastAuthErr
+			a.mu.RUnlock()
+			if lastErr != nil {
+				return fmt.Errorf("auth.EnsureValidToken: %w: %w", ErrTokenQueueFull, lastErr)
+			}
+			return fmt.Errorf("auth.EnsureValidToken: %w", ErrTokenQueueFull)
+		}
+		defer a.tokenWaiters.Add(-1)
+	}
+
+	a.refreshMu.Lock()
+	defer a.refreshMu.Unlock()
+
+	// Another caller may have refreshed the token while we were waiting
+	if !a.IsTokenExpired() {
+		return nil
+	}
+
+	slog.DebugContext(ctx, "token expired, refreshing")
+	err := a.Authenticate(ctx)
+
+	a.mu.Lock()
+	a.lastAuthErr = err
+	a.mu.Unlock()
+
+	return err
 }
 
 // NewAuth creates a new Auth instance with the provided credentials
@@ -133,6 +276,10 @@ func NewAuth(clientID, clientSecret string, opts ...AuthOption) (*Auth, error) {
 		opt(auth)
 	}
 
+	if len(auth.optionErrors) > 0 {
+		return nil, fmt.Errorf("auth.NewAuth: invalid options: %w", errors.Join(auth.optionErrors...))
+	}
+
 	// Create default client if none was set by options
 	if auth.client == nil {
 		auth.client = &http.Client{
@@ -140,11 +287,46 @@ func NewAuth(clientID, clientSecret string, opts ...AuthOption) (*Auth, error) {
 		}
 	}
 
+	// Start from the stored token; EnsureValidToken authenticates if it is missing or expired
+	if auth.tokenStore != nil {
+		token, expiry, err := auth.tokenStore.Load()
+		if err != nil {
+			slog.Warn("failed to load token from token store", "error", err)
+		} else if token != "" {
+			auth.Token = token
+			auth.ExpiresAt = expiry
+		}
+	}
+
 	slog.Debug("creating new auth client", "auth", auth)
 
 	return auth, nil
 }
 
+// NewAuthWithRefreshToken creates a new Auth instance that acts on behalf of a user.
+// The refresh token, obtained through Reddit's authorization code flow with duration=permanent,
+// is exchanged for access tokens using the refresh_token grant, and access tokens are refreshed
+// the same way EnsureValidToken refreshes app-only tokens. Use WithTokenUpdateCallback to persist
+// refreshed tokens.
+func NewAuthWithRefreshToken(clientID, clientSecret, refreshToken string, opts ...AuthOption) (*Auth, error) {
+	if refreshToken == "" {
+		return nil, ErrMissingCredentials
+	}
+
+	auth, err := NewAuth(clientID, clientSecret, opts...)
+	if err != nil {
+		return nil, err
+	}
+	auth.refreshToken = refreshToken
+
+	return auth, nil
+}
+
+// Scopes returns the OAuth scopes requested with WithAuthScopes, or nil if none were set
+func (a *Auth) Scopes() []string {
+	return slices.Clone(a.scopes)
+}
+
 // String returns a string representation of the Auth struct, safely handling sensitive data
 func (a *Auth) String() string {
 	if a == nil {
@@ -157,7 +339,10 @@ func (a *Auth) String() string {
 		clientSecret = clientSecret[:4] + "..."
 	}
 
+	a.mu.RLock()
 	token := a.Token
+	expiresAt := a.ExpiresAt
+	a.mu.RUnlock()
 	if len(token) > 4 {
 		token = token[:4] + "..."
 	}
@@ -166,7 +351,7 @@ func (a *Auth) String() string {
 		a.ClientID, // Show full client ID as it's public
 		clientSecret,
 		token,
-		a.ExpiresAt,
+		expiresAt,
 		a.userAgent,
 		a.timeout,
 	)
@@ -1,7 +1,9 @@
 package reddit
 
 import (
+	"fmt"
 	"net/http"
+	"slices"
 	"time"
 )
 
@@ -42,3 +44,78 @@ func WithAuthHTTPClient(client *http.Client) AuthOption {
 		a.client = client
 	}
 }
+
+// WithAuthRefreshThreshold sets how long before expiry the token is proactively refreshed.
+// EnsureValidToken refreshes the token once less than threshold remains before it expires,
+// which avoids requests failing with a token that expires mid-flight. Defaults to 60 seconds.
+// Negative values are ignored.
+func WithAuthRefreshThreshold(threshold time.Duration) AuthOption {
+	return func(a *Auth) {
+		if threshold >= 0 {
+			a.refreshThreshold = threshold
+			a.refreshThresholdSet = true
+		}
+	}
+}
+
+// WithTokenUpdateCallback sets a callback invoked with the new access token and its expiry
+// after each successful authentication, allowing applications to persist refreshed tokens.
+func WithTokenUpdateCallback(callback TokenUpdateCallback) AuthOption {
+	return func(a *Auth) {
+		a.onTokenUpdate = callback
+	}
+}
+
+// WithTokenStore sets a store the Auth loads its initial token from when it is created, and saves
+// the token to after each successful authentication. A stored token that has not expired is used
+// without contacting Reddit. Load and save errors are logged rather than returned.
+func WithTokenStore(store TokenStore) AuthOption {
+	return func(a *Auth) {
+		a.tokenStore = store
+	}
+}
+
+// WithMaxConcurrentTokenWaiters bounds how many goroutines may wait on a token refresh at once.
+// Concurrent callers of EnsureValidToken share a single refresh, but if the auth endpoint is down
+// each queued caller retries in turn. Once max callers are refreshing or queued, further callers
+// fail immediately with an error wrapping ErrTokenQueueFull and the last authentication
+// error, if any. Zero, the default, leaves the queue unbounded; negative values are ignored.
+func WithMaxConcurrentTokenWaiters(max int) AuthOption {
+	return func(a *Auth) {
+		if max >= 0 {
+			a.maxTokenWaiters = max
+		}
+	}
+}
+
+// knownScopes are the OAuth scopes Reddit defines, as listed by /api/v1/scopes. "*" requests all of them.
+var knownScopes = []string{
+	"*", "account", "creddits", "edit", "flair", "history", "identity", "livemanage", "modconfig",
+	"modcontributors", "modflair", "modlog", "modmail", "modothers", "modposts", "modself",
+	"modwiki", "mysubreddits", "privatemessages", "read", "report", "save", "structuredstyles",
+	"submit", "subscribe", "vote", "wikiedit", "wikiread",
+}
+
+// WithAuthScopes sets the OAuth scopes sent as the scope parameter of each access token request,
+// such as "read", "identity" and "submit". Without it no scope is sent and Reddit grants its
+// default. NewAuth returns an error if no scopes are given, or if a scope is empty or not one
+// Reddit defines, in which case the error wraps ErrInvalidScope.
+func WithAuthScopes(scopes ...string) AuthOption {
+	return func(a *Auth) {
+		if len(scopes) == 0 {
+			a.optionErrors = append(a.optionErrors, fmt.Errorf("auth.WithAuthScopes: no scopes given"))
+			return
+		}
+		for _, scope := range scopes {
+			if scope == "" {
+				a.optionErrors = append(a.optionErrors, fmt.Errorf("auth.WithAuthScopes: scope is empty"))
+				return
+			}
+			if !slices.Contains(knownScopes, scope) {
+				a.optionErrors = append(a.optionErrors, fmt.Errorf("auth.WithAuthScopes: %w: %q", ErrInvalidScope, scope))
+				return
+			}
+		}
+		a.scopes = slices.Clone(scopes)
+	}
+}
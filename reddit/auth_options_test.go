@@ -2,9 +2,13 @@ package reddit_test
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/JohnPlummer/reddit-client/reddit"
@@ -218,6 +222,277 @@ var _ = Describe("Auth Options", func() {
 		})
 	})
 
+	Describe("WithAuthRefreshThreshold", func() {
+		var ctx context.Context
+
+		BeforeEach(func() {
+			ctx = context.Background()
+		})
+
+		It("refreshes a token that is about to expire before its hard expiry", func() {
+			var err error
+			auth, err = reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthTransport(transport),
+				reddit.WithAuthRefreshThreshold(5*time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+
+			auth.Token = "near_expiry_token"
+			auth.ExpiresAt = time.Now().Add(2 * time.Minute)
+			Expect(auth.IsTokenExpired()).To(BeTrue())
+
+			Expect(auth.EnsureValidToken(ctx)).To(Succeed())
+			Expect(transport.GetCallCount()).To(Equal(1))
+			Expect(auth.Token).To(Equal("test_token"))
+			Expect(auth.TokenExpiry()).To(BeTemporally(">", time.Now().Add(30*time.Minute)))
+		})
+
+		It("keeps a token with more time left than the threshold", func() {
+			var err error
+			auth, err = reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthTransport(transport),
+				reddit.WithAuthRefreshThreshold(30*time.Second))
+			Expect(err).NotTo(HaveOccurred())
+
+			auth.Token = "valid_token"
+			auth.ExpiresAt = time.Now().Add(2 * time.Minute)
+
+			Expect(auth.EnsureValidToken(ctx)).To(Succeed())
+			Expect(transport.GetCallCount()).To(Equal(0))
+			Expect(auth.Token).To(Equal("valid_token"))
+		})
+
+		It("defaults to refreshing within the last minute", func() {
+			var err error
+			auth, err = reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthTransport(transport))
+			Expect(err).NotTo(HaveOccurred())
+
+			auth.ExpiresAt = time.Now().Add(30 * time.Second)
+			Expect(auth.IsTokenExpired()).To(BeTrue())
+
+			auth.ExpiresAt = time.Now().Add(2 * time.Minute)
+			Expect(auth.IsTokenExpired()).To(BeFalse())
+		})
+
+		It("defaults to refreshing within the last minute for an Auth built without NewAuth", func() {
+			auth = &reddit.Auth{ExpiresAt: time.Now().Add(30 * time.Second)}
+			Expect(auth.IsTokenExpired()).To(BeTrue())
+
+			auth = &reddit.Auth{ExpiresAt: time.Now().Add(2 * time.Minute)}
+			Expect(auth.IsTokenExpired()).To(BeFalse())
+		})
+
+		It("keeps an explicit zero threshold", func() {
+			var err error
+			auth, err = reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthRefreshThreshold(0))
+			Expect(err).NotTo(HaveOccurred())
+
+			auth.ExpiresAt = time.Now().Add(30 * time.Second)
+			Expect(auth.IsTokenExpired()).To(BeFalse())
+		})
+
+		It("ignores negative thresholds", func() {
+			var err error
+			auth, err = reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthRefreshThreshold(-time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+
+			auth.ExpiresAt = time.Now().Add(30 * time.Second)
+			Expect(auth.IsTokenExpired()).To(BeTrue())
+		})
+	})
+
+	Describe("WithTokenStore", func() {
+		var ctx context.Context
+
+		BeforeEach(func() {
+			ctx = context.Background()
+		})
+
+		It("uses a valid stored token without calling the token endpoint", func() {
+			store := &memoryTokenStore{token: "stored_token", expiry: time.Now().Add(30 * time.Minute)}
+			var err error
+			auth, err = reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthTransport(transport),
+				reddit.WithTokenStore(store))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(auth.EnsureValidToken(ctx)).To(Succeed())
+			Expect(transport.GetCallCount()).To(Equal(0))
+			Expect(auth.Token).To(Equal("stored_token"))
+			Expect(store.saves).To(BeZero())
+		})
+
+		It("authenticates and saves the new token when the stored one has expired", func() {
+			store := &memoryTokenStore{token: "stale_token", expiry: time.Now().Add(-time.Minute)}
+			var err error
+			auth, err = reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthTransport(transport),
+				reddit.WithTokenStore(store))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(auth.EnsureValidToken(ctx)).To(Succeed())
+			Expect(transport.GetCallCount()).To(Equal(1))
+			Expect(store.token).To(Equal("test_token"))
+			Expect(store.expiry).To(BeTemporally("~", auth.ExpiresAt))
+			Expect(store.saves).To(Equal(1))
+		})
+
+		It("authenticates when the store is empty or fails to load", func() {
+			for _, store := range []*memoryTokenStore{{}, {loadErr: errors.New("disk unavailable")}} {
+				transport.Reset()
+				var err error
+				auth, err = reddit.NewAuth("test_id", "test_secret",
+					reddit.WithAuthTransport(transport),
+					reddit.WithTokenStore(store))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(auth.EnsureValidToken(ctx)).To(Succeed())
+				Expect(transport.GetCallCount()).To(Equal(1))
+				Expect(auth.Token).To(Equal("test_token"))
+			}
+		})
+
+		It("still authenticates when saving fails", func() {
+			store := &memoryTokenStore{saveErr: errors.New("disk full")}
+			var err error
+			auth, err = reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthTransport(transport),
+				reddit.WithTokenStore(store))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(auth.EnsureValidToken(ctx)).To(Succeed())
+			Expect(auth.Token).To(Equal("test_token"))
+		})
+	})
+
+	Describe("WithMaxConcurrentTokenWaiters", func() {
+		It("fails fast once too many callers wait on a failing auth endpoint", func() {
+			endpoint := &failingTokenEndpoint{delay: 200 * time.Millisecond}
+			auth, err := reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthTransport(endpoint),
+				reddit.WithMaxConcurrentTokenWaiters(2))
+			Expect(err).NotTo(HaveOccurred())
+
+			const callers = 20
+			var (
+				wg        sync.WaitGroup
+				mu        sync.Mutex
+				queueFull int
+				fastFails int
+			)
+			for i := 0; i < callers; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					start := time.Now()
+					err := auth.EnsureValidToken(context.Background())
+					Expect(err).To(HaveOccurred())
+
+					mu.Lock()
+					defer mu.Unlock()
+					if errors.Is(err, reddit.ErrTokenQueueFull) {
+						queueFull++
+						if time.Since(start) < 100*time.Millisecond {
+							fastFails++
+						}
+					}
+				}()
+			}
+			wg.Wait()
+
+			// At most two callers reach the endpoint, the rest are turned away without blocking
+			Expect(endpoint.calls.Load()).To(BeNumerically("<=", 2))
+			Expect(queueFull).To(BeNumerically(">=", callers-2))
+			Expect(fastFails).To(Equal(queueFull))
+		})
+
+		It("includes the last authentication error when failing fast", func() {
+			endpoint := &failingTokenEndpoint{}
+			auth, err := reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthTransport(endpoint),
+				reddit.WithMaxConcurrentTokenWaiters(1))
+			Expect(err).NotTo(HaveOccurred())
+
+			// Record a failed refresh, then hold the only slot with a slow refresh
+			Expect(auth.EnsureValidToken(context.Background())).NotTo(Succeed())
+			endpoint.delay = 200 * time.Millisecond
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_ = auth.EnsureValidToken(context.Background())
+			}()
+			Eventually(endpoint.calls.Load).Should(Equal(int32(2)))
+
+			err = auth.EnsureValidToken(context.Background())
+			Expect(errors.Is(err, reddit.ErrTokenQueueFull)).To(BeTrue())
+			Expect(errors.Is(err, reddit.ErrServerError)).To(BeTrue())
+			<-done
+		})
+
+		It("leaves the queue unbounded by default", func() {
+			endpoint := &failingTokenEndpoint{}
+			auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(endpoint))
+			Expect(err).NotTo(HaveOccurred())
+
+			var wg sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					err := auth.EnsureValidToken(context.Background())
+					Expect(errors.Is(err, reddit.ErrTokenQueueFull)).To(BeFalse())
+				}()
+			}
+			wg.Wait()
+			Expect(endpoint.calls.Load()).To(Equal(int32(5)))
+		})
+	})
+
+	Describe("WithAuthScopes", func() {
+		It("sends the scopes with the token request and stores them", func() {
+			tokenEndpoint := &tokenEndpointTransport{tokens: []string{"scoped_token"}}
+			auth, err := reddit.NewAuth("test_id", "test_secret",
+				reddit.WithAuthTransport(tokenEndpoint),
+				reddit.WithAuthScopes("read", "identity"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(auth.Authenticate(context.Background())).To(Succeed())
+			Expect(tokenEndpoint.forms).To(HaveLen(1))
+			Expect(tokenEndpoint.forms[0].Get("scope")).To(Equal("read identity"))
+			Expect(auth.Scopes()).To(Equal([]string{"read", "identity"}))
+		})
+
+		It("sends no scope by default", func() {
+			tokenEndpoint := &tokenEndpointTransport{tokens: []string{"app_token"}}
+			auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(tokenEndpoint))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(auth.Authenticate(context.Background())).To(Succeed())
+			Expect(tokenEndpoint.forms[0].Has("scope")).To(BeFalse())
+			Expect(auth.Scopes()).To(BeNil())
+		})
+
+		It("rejects unknown scopes", func() {
+			auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthScopes("read", "everything"))
+			Expect(errors.Is(err, reddit.ErrInvalidScope)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`"everything"`))
+			Expect(auth).To(BeNil())
+		})
+
+		It("rejects empty scopes", func() {
+			_, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthScopes())
+			Expect(err).To(MatchError(ContainSubstring("auth.WithAuthScopes: no scopes given")))
+
+			_, err = reddit.NewAuth("test_id", "test_secret", reddit.WithAuthScopes("read", ""))
+			Expect(err).To(MatchError(ContainSubstring("auth.WithAuthScopes: scope is empty")))
+		})
+	})
+
 	Describe("Combined Options", func() {
 		It("applies timeout after setting custom client", func() {
 			customClient := &http.Client{
@@ -330,3 +605,135 @@ var _ = Describe("Auth JSON Response Handling", func() {
 		})
 	})
 })
+
+var _ = Describe("Refresh Token Authentication", func() {
+	var (
+		ctx           context.Context
+		tokenEndpoint *tokenEndpointTransport
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		tokenEndpoint = &tokenEndpointTransport{tokens: []string{"user_token_1", "user_token_2"}}
+	})
+
+	It("requires a refresh token", func() {
+		auth, err := reddit.NewAuthWithRefreshToken("test_id", "test_secret", "")
+		Expect(err).To(Equal(reddit.ErrMissingCredentials))
+		Expect(auth).To(BeNil())
+	})
+
+	It("exchanges the refresh token for an access token", func() {
+		auth, err := reddit.NewAuthWithRefreshToken("test_id", "test_secret", "refresh_abc",
+			reddit.WithAuthTransport(tokenEndpoint))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(auth.EnsureValidToken(ctx)).To(Succeed())
+
+		Expect(tokenEndpoint.forms).To(HaveLen(1))
+		Expect(tokenEndpoint.forms[0].Get("grant_type")).To(Equal("refresh_token"))
+		Expect(tokenEndpoint.forms[0].Get("refresh_token")).To(Equal("refresh_abc"))
+		Expect(auth.Token).To(Equal("user_token_1"))
+		Expect(auth.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+	})
+
+	It("refreshes the access token when it expires and reports each update", func() {
+		var updates []string
+		auth, err := reddit.NewAuthWithRefreshToken("test_id", "test_secret", "refresh_abc",
+			reddit.WithAuthTransport(tokenEndpoint),
+			reddit.WithTokenUpdateCallback(func(accessToken string, expiry time.Time) {
+				Expect(expiry).To(BeTemporally(">", time.Now()))
+				updates = append(updates, accessToken)
+			}))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(auth.EnsureValidToken(ctx)).To(Succeed())
+		Expect(auth.EnsureValidToken(ctx)).To(Succeed()) // Still valid, no exchange
+		Expect(tokenEndpoint.forms).To(HaveLen(1))
+
+		auth.ExpiresAt = time.Now().Add(-time.Second)
+		Expect(auth.EnsureValidToken(ctx)).To(Succeed())
+
+		Expect(tokenEndpoint.forms).To(HaveLen(2))
+		Expect(tokenEndpoint.forms[1].Get("grant_type")).To(Equal("refresh_token"))
+		Expect(auth.Token).To(Equal("user_token_2"))
+		Expect(updates).To(Equal([]string{"user_token_1", "user_token_2"}))
+	})
+
+	It("keeps using client credentials for app-only auth", func() {
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(tokenEndpoint))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(auth.Authenticate(ctx)).To(Succeed())
+		Expect(tokenEndpoint.forms[0].Get("grant_type")).To(Equal("client_credentials"))
+		Expect(tokenEndpoint.forms[0].Has("refresh_token")).To(BeFalse())
+	})
+})
+
+// tokenEndpointTransport records the form submitted to the token endpoint and
+// answers with the configured access tokens in order
+type tokenEndpointTransport struct {
+	tokens []string
+	forms  []url.Values
+}
+
+func (t *tokenEndpointTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	t.forms = append(t.forms, form)
+
+	token := t.tokens[(len(t.forms)-1)%len(t.tokens)]
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"access_token": "` + token + `", "token_type": "bearer", "expires_in": 3600}`)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+// failingTokenEndpoint answers every token request with a 500 after an optional delay
+type failingTokenEndpoint struct {
+	delay time.Duration
+	calls atomic.Int32
+}
+
+func (t *failingTokenEndpoint) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls.Add(1)
+	time.Sleep(t.delay)
+	return &http.Response{
+		StatusCode: http.StatusInternalServerError,
+		Body:       http.NoBody,
+		Header:     make(http.Header),
+	}, nil
+}
+
+// memoryTokenStore is a TokenStore that keeps the token in memory and can be set up to fail
+type memoryTokenStore struct {
+	token   string
+	expiry  time.Time
+	saves   int
+	loadErr error
+	saveErr error
+}
+
+func (s *memoryTokenStore) Load() (string, time.Time, error) {
+	if s.loadErr != nil {
+		return "", time.Time{}, s.loadErr
+	}
+	return s.token, s.expiry, nil
+}
+
+func (s *memoryTokenStore) Save(token string, expiry time.Time) error {
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	s.token = token
+	s.expiry = expiry
+	s.saves++
+	return nil
+}
@@ -0,0 +1,56 @@
+package reddit
+
+import (
+	"sync"
+	"time"
+)
+
+// Cache stores raw response bodies for WithCache. Implementations must be safe for concurrent
+// use and should stop returning an entry once its ttl has passed.
+type Cache interface {
+	// Get returns the body stored under key, if present and not expired
+	Get(key string) ([]byte, bool)
+
+	// Set stores body under key for ttl
+	Set(key string, body []byte, ttl time.Duration)
+}
+
+// MemoryCache is an in-memory Cache. Expired entries are dropped when they are next looked up.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	body      []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache creates an empty in-memory cache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+// Get returns the body stored under key, if present and not expired
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// Set stores body under key for ttl
+func (m *MemoryCache) Set(key string, body []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[key] = memoryCacheEntry{body: body, expiresAt: time.Now().Add(ttl)}
+}
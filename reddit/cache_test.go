@@ -0,0 +1,260 @@
+package reddit_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/JohnPlummer/reddit-client/reddit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MemoryCache", func() {
+	It("returns stored bodies until they expire", func() {
+		cache := reddit.NewMemoryCache()
+		cache.Set("key", []byte("body"), 50*time.Millisecond)
+
+		body, ok := cache.Get("key")
+		Expect(ok).To(BeTrue())
+		Expect(string(body)).To(Equal("body"))
+
+		time.Sleep(60 * time.Millisecond)
+		_, ok = cache.Get("key")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("misses unknown keys", func() {
+		_, ok := reddit.NewMemoryCache().Get("missing")
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("Client response cache", func() {
+	var (
+		transport *listingTransport
+		auth      *reddit.Auth
+	)
+
+	BeforeEach(func() {
+		transport = &listingTransport{}
+		var err error
+		auth, err = reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("serves identical GET requests from the cache", func() {
+		client, err := reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithCache(reddit.NewMemoryCache(), time.Minute),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		subreddit := reddit.NewSubreddit("golang", client)
+
+		first, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+		second, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(transport.requests.Load()).To(Equal(int32(1)))
+		Expect(second).To(HaveLen(1))
+		Expect(second[0].ID).To(Equal(first[0].ID))
+	})
+
+	It("fetches again once the entry expires", func() {
+		client, err := reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithCache(reddit.NewMemoryCache(), 20*time.Millisecond),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		subreddit := reddit.NewSubreddit("golang", client)
+
+		_, err = subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+		time.Sleep(30 * time.Millisecond)
+		_, err = subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(transport.requests.Load()).To(Equal(int32(2)))
+	})
+
+	It("bypasses the cache when body interceptors are registered", func() {
+		client, err := reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithCache(reddit.NewMemoryCache(), time.Minute),
+			reddit.WithBodyResponseInterceptor(func(resp *http.Response, body []byte) error { return nil }),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		subreddit := reddit.NewSubreddit("golang", client)
+
+		for i := 0; i < 2; i++ {
+			_, err = subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(transport.requests.Load()).To(Equal(int32(2)))
+	})
+
+	It("bypasses the cache for requests carrying context headers", func() {
+		client, err := reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithCache(reddit.NewMemoryCache(), time.Minute),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		subreddit := reddit.NewSubreddit("golang", client)
+
+		ctx := reddit.ContextWithHeader(context.Background(), "X-Tenant", "a")
+		for i := 0; i < 2; i++ {
+			_, err = subreddit.GetPosts(ctx, reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(transport.requests.Load()).To(Equal(int32(2)))
+	})
+
+	It("bypasses the cache when request interceptors are registered", func() {
+		client, err := reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithCache(reddit.NewMemoryCache(), time.Minute),
+			reddit.WithRequestInterceptor(func(req *http.Request) error { return nil }),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		subreddit := reddit.NewSubreddit("golang", client)
+
+		for i := 0; i < 2; i++ {
+			_, err = subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(transport.requests.Load()).To(Equal(int32(2)))
+	})
+
+	It("keeps the responses of clients acting for different users apart", func() {
+		cache := reddit.NewMemoryCache()
+		fetchAs := func(refreshToken string) {
+			userAuth, err := reddit.NewAuthWithRefreshToken("test_id", "test_secret", refreshToken,
+				reddit.WithAuthTransport(transport))
+			Expect(err).NotTo(HaveOccurred())
+			client, err := reddit.NewClient(userAuth,
+				reddit.WithHTTPClient(&http.Client{Transport: transport}),
+				reddit.WithCache(cache, time.Minute),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = reddit.NewSubreddit("golang", client).GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		fetchAs("alice_refresh_token")
+		fetchAs("bob_refresh_token")
+		Expect(transport.requests.Load()).To(Equal(int32(2)))
+
+		fetchAs("alice_refresh_token")
+		Expect(transport.requests.Load()).To(Equal(int32(2)))
+	})
+
+	Context("with conditional requests", func() {
+		var etags *etagTransport
+
+		BeforeEach(func() {
+			etags = &etagTransport{next: transport, etag: `"v1"`}
+		})
+
+		It("returns the previously cached posts when the server answers 304", func() {
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: etags}),
+				reddit.WithCache(reddit.NewMemoryCache(), 20*time.Millisecond),
+				reddit.WithConditionalRequests(time.Minute),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit := reddit.NewSubreddit("golang", client)
+
+			first, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+			time.Sleep(30 * time.Millisecond)
+			second, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+
+			// The revalidation reached the server, which would have sent a post with a new ID
+			Expect(etags.ifNoneMatch).To(Equal([]string{"", `"v1"`}))
+			Expect(etags.notModified).To(Equal(1))
+			Expect(second).To(HaveLen(1))
+			Expect(second[0].ID).To(Equal(first[0].ID))
+
+			// The 304 refreshes the cache entry, so the next request is served locally
+			_, err = subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(etags.ifNoneMatch).To(HaveLen(2))
+		})
+
+		It("decodes the new body when the resource changed", func() {
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: etags}),
+				reddit.WithCache(reddit.NewMemoryCache(), 20*time.Millisecond),
+				reddit.WithConditionalRequests(time.Minute),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit := reddit.NewSubreddit("golang", client)
+
+			first, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+			etags.etag = `"v2"`
+			time.Sleep(30 * time.Millisecond)
+			second, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(etags.notModified).To(BeZero())
+			Expect(second[0].ID).NotTo(Equal(first[0].ID))
+		})
+
+		It("requires a cache and a positive max age", func() {
+			_, err := reddit.NewClient(auth, reddit.WithConditionalRequests(time.Minute))
+			Expect(err).To(MatchError(ContainSubstring("client.WithConditionalRequests: requires WithCache")))
+
+			_, err = reddit.NewClient(auth,
+				reddit.WithCache(reddit.NewMemoryCache(), time.Minute),
+				reddit.WithConditionalRequests(0),
+			)
+			Expect(err).To(MatchError(ContainSubstring("client.WithConditionalRequests: max age must be positive")))
+		})
+	})
+
+	It("rejects a nil cache or non-positive ttl", func() {
+		_, err := reddit.NewClient(auth, reddit.WithCache(nil, time.Minute))
+		Expect(err).To(MatchError(ContainSubstring("client.WithCache: cache is nil")))
+
+		_, err = reddit.NewClient(auth, reddit.WithCache(reddit.NewMemoryCache(), 0))
+		Expect(err).To(MatchError(ContainSubstring("client.WithCache: ttl must be positive")))
+	})
+})
+
+// etagTransport tags the listings returned by next with etag and answers requests whose
+// If-None-Match matches it with an empty 304 Not Modified. It is not safe for concurrent use.
+type etagTransport struct {
+	next        http.RoundTripper
+	etag        string
+	ifNoneMatch []string // If-None-Match header of each API request, "" when unset
+	notModified int
+}
+
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path == "/api/v1/access_token" {
+		return t.next.RoundTrip(req)
+	}
+
+	t.ifNoneMatch = append(t.ifNoneMatch, req.Header.Get("If-None-Match"))
+	if req.Header.Get("If-None-Match") == t.etag {
+		t.notModified++
+		return &http.Response{
+			StatusCode: http.StatusNotModified,
+			Header:     http.Header{"Etag": []string{t.etag}},
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Header.Set("ETag", t.etag)
+	return resp, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 )
@@ -54,7 +55,10 @@ type CircuitBreakerConfig struct {
 	// If nil, all errors count as failures
 	ShouldTrip func(error) bool
 
-	// OnStateChange is called when the circuit state changes
+	// OnStateChange is called whenever the circuit moves between closed, open and half-open.
+	// Transitions are reported synchronously and in order, just after the breaker's lock is
+	// released, so the callback may call State or Counts but must not call Execute.
+	// If nil, transitions are only logged at debug level.
 	OnStateChange func(from, to CircuitState)
 }
 
@@ -87,14 +91,53 @@ type CircuitBreaker struct {
 	successCount     int
 	lastFailureTime  time.Time
 	halfOpenRequests int
+
+	// lifetime counters reported by Metrics
+	totalRequests       int64
+	totalFailures       int64
+	totalSuccesses      int64
+	consecutiveFailures int64
+
+	// transitions queued for OnStateChange; notifyMu serializes their delivery
+	pendingTransitions []stateTransition
+	notifyMu           sync.Mutex
+}
+
+// stateTransition records a state change awaiting delivery to OnStateChange
+type stateTransition struct {
+	from, to CircuitState
 }
 
-// CircuitBreakerError represents an error when the circuit breaker is open
+// CircuitBreakerMetrics is a snapshot of a circuit breaker's counters, suitable for exporting to
+// dashboards. TotalRequests includes requests rejected while the circuit was open or half-open, so
+// TotalRequests - TotalSuccesses - TotalFailures is the number of rejected requests.
+type CircuitBreakerMetrics struct {
+	TotalRequests       int64        // every call to Execute
+	TotalFailures       int64        // executed requests that returned an error, whether or not it tripped the circuit
+	TotalSuccesses      int64        // executed requests that succeeded
+	ConsecutiveFailures int64        // failures since the last success
+	State               CircuitState // state at the time of the snapshot
+}
+
+// Reasons a circuit breaker rejects a request, reported in CircuitBreakerError.Reason
+const (
+	// CircuitBreakerReasonOpen means the circuit is open; retry after the breaker's Timeout
+	CircuitBreakerReasonOpen = "open"
+	// CircuitBreakerReasonHalfOpenLimit means the circuit is half-open and already has MaxRequests
+	// probe requests in flight; the upstream may have recovered, so retry shortly
+	CircuitBreakerReasonHalfOpenLimit = "half_open_limit"
+)
+
+// CircuitBreakerError represents an error when the circuit breaker rejects a request
 type CircuitBreakerError struct {
-	State CircuitState
+	State  CircuitState
+	Reason string // why the request was rejected, one of the CircuitBreakerReason constants
 }
 
 func (e *CircuitBreakerError) Error() string {
+	if e.Reason == CircuitBreakerReasonHalfOpenLimit {
+		return fmt.Sprintf("circuit breaker is %s: too many probe requests in flight", e.State.String())
+	}
 	return fmt.Sprintf("circuit breaker is %s", e.State.String())
 }
 
@@ -143,11 +186,59 @@ func (cb *CircuitBreaker) Counts() (failures, successes int) {
 	return cb.failureCount, cb.successCount
 }
 
+// Metrics returns a consistent snapshot of the circuit breaker's counters and current state
+func (cb *CircuitBreaker) Metrics() CircuitBreakerMetrics {
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+
+	return CircuitBreakerMetrics{
+		TotalRequests:       cb.totalRequests,
+		TotalFailures:       cb.totalFailures,
+		TotalSuccesses:      cb.totalSuccesses,
+		ConsecutiveFailures: cb.consecutiveFailures,
+		State:               cb.state,
+	}
+}
+
+// Reset forces the circuit closed and clears its failure and success counts, for example after a
+// deploy has fixed the upstream. Lifetime counters reported by Metrics are kept.
+func (cb *CircuitBreaker) Reset() {
+	defer cb.notifyStateChanges()
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state != CircuitClosed {
+		cb.transitionTo(CircuitClosed)
+	}
+	cb.failureCount = 0
+	cb.successCount = 0
+	cb.halfOpenRequests = 0
+	cb.consecutiveFailures = 0
+}
+
+// Trip forces the circuit open, rejecting requests until the configured Timeout has passed and
+// the circuit moves to half-open as it would after tripping on failures
+func (cb *CircuitBreaker) Trip() {
+	defer cb.notifyStateChanges()
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state != CircuitOpen {
+		cb.transitionTo(CircuitOpen)
+	}
+	cb.lastFailureTime = time.Now()
+	cb.successCount = 0
+	cb.halfOpenRequests = 0
+}
+
 // canRequest determines if a request can be made based on the current state
 func (cb *CircuitBreaker) canRequest() error {
+	defer cb.notifyStateChanges()
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
+	cb.totalRequests++
+
 	switch cb.state {
 	case CircuitClosed:
 		return nil
@@ -155,19 +246,19 @@ func (cb *CircuitBreaker) canRequest() error {
 		// Check if enough time has passed to transition to half-open
 		if time.Since(cb.lastFailureTime) >= cb.config.Timeout {
 			cb.transitionTo(CircuitHalfOpen)
-			cb.halfOpenRequests = 0
+			cb.halfOpenRequests = 1 // this request is the first probe
 			return nil
 		}
-		return &CircuitBreakerError{State: CircuitOpen}
+		return &CircuitBreakerError{State: CircuitOpen, Reason: CircuitBreakerReasonOpen}
 	case CircuitHalfOpen:
 		// Check if we can allow more requests in half-open state
 		if cb.config.MaxRequests == 0 {
 			// Only one request at a time
 			if cb.halfOpenRequests > 0 {
-				return &CircuitBreakerError{State: CircuitHalfOpen}
+				return &CircuitBreakerError{State: CircuitHalfOpen, Reason: CircuitBreakerReasonHalfOpenLimit}
 			}
 		} else if cb.halfOpenRequests >= cb.config.MaxRequests {
-			return &CircuitBreakerError{State: CircuitHalfOpen}
+			return &CircuitBreakerError{State: CircuitHalfOpen, Reason: CircuitBreakerReasonHalfOpenLimit}
 		}
 		cb.halfOpenRequests++
 		return nil
@@ -178,9 +269,13 @@ func (cb *CircuitBreaker) canRequest() error {
 
 // onSuccess records a successful request
 func (cb *CircuitBreaker) onSuccess() {
+	defer cb.notifyStateChanges()
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
+	cb.totalSuccesses++
+	cb.consecutiveFailures = 0
+
 	switch cb.state {
 	case CircuitClosed:
 		// Reset failure count on success
@@ -199,9 +294,13 @@ func (cb *CircuitBreaker) onSuccess() {
 
 // onFailure records a failed request
 func (cb *CircuitBreaker) onFailure(err error) {
+	defer cb.notifyStateChanges()
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
+	cb.totalFailures++
+	cb.consecutiveFailures++
+
 	// Always decrement half-open requests counter if we're in half-open state
 	if cb.state == CircuitHalfOpen {
 		cb.halfOpenRequests--
@@ -228,7 +327,8 @@ func (cb *CircuitBreaker) onFailure(err error) {
 	}
 }
 
-// transitionTo changes the circuit state and calls the state change callback
+// transitionTo changes the circuit state and queues the change for the state change callback.
+// It must be called with cb.mu held.
 func (cb *CircuitBreaker) transitionTo(newState CircuitState) {
 	oldState := cb.state
 	cb.state = newState
@@ -240,8 +340,24 @@ func (cb *CircuitBreaker) transitionTo(newState CircuitState) {
 		"success_count", cb.successCount)
 
 	if cb.config.OnStateChange != nil {
-		// Call the callback without holding the lock to prevent deadlocks
-		go cb.config.OnStateChange(oldState, newState)
+		cb.pendingTransitions = append(cb.pendingTransitions, stateTransition{from: oldState, to: newState})
+	}
+}
+
+// notifyStateChanges delivers queued transitions to the state change callback. It is called after
+// cb.mu has been released so the callback can't deadlock on the breaker; notifyMu keeps concurrent
+// deliveries in the order the transitions happened.
+func (cb *CircuitBreaker) notifyStateChanges() {
+	cb.notifyMu.Lock()
+	defer cb.notifyMu.Unlock()
+
+	cb.mu.Lock()
+	pending := cb.pendingTransitions
+	cb.pendingTransitions = nil
+	cb.mu.Unlock()
+
+	for _, t := range pending {
+		cb.config.OnStateChange(t.from, t.to)
 	}
 }
 
@@ -265,6 +381,85 @@ func (cb *CircuitBreaker) Execute(fn func() error) error {
 	return nil
 }
 
+// endpointCircuitBreakers holds one CircuitBreaker per request path, created lazily on first use,
+// so that a failing endpoint doesn't fast-fail requests to healthy ones
+type endpointCircuitBreakers struct {
+	config *CircuitBreakerConfig
+
+	mu       sync.Mutex
+	breakers map[string]*CircuitBreaker
+}
+
+// newEndpointCircuitBreakers creates an empty set of per-endpoint breakers sharing config
+func newEndpointCircuitBreakers(config *CircuitBreakerConfig) *endpointCircuitBreakers {
+	if config == nil {
+		config = DefaultCircuitBreakerConfig()
+	}
+	return &endpointCircuitBreakers{
+		config:   config,
+		breakers: make(map[string]*CircuitBreaker),
+	}
+}
+
+// forEndpoint returns the breaker for the endpoint's template, ignoring any query string
+func (e *endpointCircuitBreakers) forEndpoint(endpoint string) *CircuitBreaker {
+	path, _, _ := strings.Cut(endpoint, "?")
+	key := endpointTemplate(path)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	cb, ok := e.breakers[key]
+	if !ok {
+		// Each breaker gets its own copy of the config, as NewCircuitBreaker fills in defaults
+		config := *e.config
+		cb = NewCircuitBreaker(&config)
+		e.breakers[key] = cb
+	}
+	return cb
+}
+
+// endpointTemplate replaces the post, comment and user identifiers in a request path with
+// placeholders, keeping any extension, so requests for different posts share one breaker:
+// /r/golang/comments/abc123 becomes /r/golang/comments/{id} and /by_id/t3_abc123.json becomes
+// /by_id/{id}.json. Subreddit names are kept, so each subreddit still has its own breaker.
+func endpointTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i := 1; i < len(segments); i++ {
+		var placeholder string
+		switch segments[i-1] {
+		case "r":
+			i++ // The subreddit name, which may itself be a word such as "comments"
+			continue
+		case "comments", "duplicates", "by_id", "_":
+			placeholder = "{id}"
+		case "user", "u":
+			placeholder = "{username}"
+		default:
+			continue
+		}
+
+		_, ext, _ := strings.Cut(segments[i], ".")
+		if ext != "" {
+			ext = "." + ext
+		}
+		segments[i] = placeholder + ext
+	}
+	return strings.Join(segments, "/")
+}
+
+// all returns the breakers created so far
+func (e *endpointCircuitBreakers) all() []*CircuitBreaker {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	breakers := make([]*CircuitBreaker, 0, len(e.breakers))
+	for _, cb := range e.breakers {
+		breakers = append(breakers, cb)
+	}
+	return breakers
+}
+
 // String returns a string representation of the circuit breaker
 func (cb *CircuitBreaker) String() string {
 	cb.mu.RLock()
@@ -207,6 +207,142 @@ var _ = Describe("CircuitBreaker", func() {
 		})
 	})
 
+	Describe("Rejection reasons", func() {
+		openCircuit := func() {
+			for i := 0; i < config.FailureThreshold; i++ {
+				circuitBreaker.Execute(func() error {
+					return errors.New("test error")
+				})
+			}
+			Expect(circuitBreaker.State()).To(Equal(reddit.CircuitOpen))
+		}
+
+		It("should report an open circuit", func() {
+			openCircuit()
+
+			err := circuitBreaker.Execute(func() error { return nil })
+			var cbErr *reddit.CircuitBreakerError
+			Expect(errors.As(err, &cbErr)).To(BeTrue())
+			Expect(cbErr.State).To(Equal(reddit.CircuitOpen))
+			Expect(cbErr.Reason).To(Equal(reddit.CircuitBreakerReasonOpen))
+		})
+
+		It("should report the half-open probe limit", func() {
+			openCircuit()
+			time.Sleep(config.Timeout + 10*time.Millisecond)
+
+			// Hold MaxRequests probes in flight
+			release := make(chan struct{})
+			var entered, done sync.WaitGroup
+			for i := 0; i < config.MaxRequests; i++ {
+				entered.Add(1)
+				done.Add(1)
+				go func() {
+					defer done.Done()
+					circuitBreaker.Execute(func() error {
+						entered.Done()
+						<-release
+						return nil
+					})
+				}()
+				entered.Wait()
+			}
+
+			err := circuitBreaker.Execute(func() error { return nil })
+			close(release)
+			done.Wait()
+
+			var cbErr *reddit.CircuitBreakerError
+			Expect(errors.As(err, &cbErr)).To(BeTrue())
+			Expect(cbErr.State).To(Equal(reddit.CircuitHalfOpen))
+			Expect(cbErr.Reason).To(Equal(reddit.CircuitBreakerReasonHalfOpenLimit))
+			Expect(err.Error()).To(ContainSubstring("too many probe requests"))
+
+			// Once the probes finish there is room for another
+			Expect(circuitBreaker.Execute(func() error { return nil })).To(Succeed())
+		})
+	})
+
+	Describe("Manual control", func() {
+		It("should force the circuit open with Trip", func() {
+			circuitBreaker.Trip()
+			Expect(circuitBreaker.State()).To(Equal(reddit.CircuitOpen))
+
+			executed := false
+			err := circuitBreaker.Execute(func() error {
+				executed = true
+				return nil
+			})
+			Expect(executed).To(BeFalse())
+			Expect(reddit.IsCircuitBreakerOpen(err)).To(BeTrue())
+
+			// The tripped circuit recovers through half-open like any other
+			time.Sleep(config.Timeout + 10*time.Millisecond)
+			Expect(circuitBreaker.Execute(func() error { return nil })).To(Succeed())
+			Expect(circuitBreaker.State()).To(Equal(reddit.CircuitHalfOpen))
+		})
+
+		It("should force the circuit closed and clear counts with Reset", func() {
+			for i := 0; i < config.FailureThreshold; i++ {
+				circuitBreaker.Execute(func() error {
+					return errors.New("test error")
+				})
+			}
+			Expect(circuitBreaker.State()).To(Equal(reddit.CircuitOpen))
+
+			circuitBreaker.Reset()
+			Expect(circuitBreaker.State()).To(Equal(reddit.CircuitClosed))
+			failures, successes := circuitBreaker.Counts()
+			Expect(failures).To(BeZero())
+			Expect(successes).To(BeZero())
+			Expect(circuitBreaker.Metrics().ConsecutiveFailures).To(BeZero())
+
+			executed := false
+			err := circuitBreaker.Execute(func() error {
+				executed = true
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(executed).To(BeTrue())
+		})
+
+		It("should report forced transitions to OnStateChange", func() {
+			var transitions []reddit.CircuitState
+			config.OnStateChange = func(from, to reddit.CircuitState) {
+				transitions = append(transitions, to)
+			}
+			circuitBreaker = reddit.NewCircuitBreaker(config)
+
+			circuitBreaker.Trip()
+			circuitBreaker.Trip()
+			circuitBreaker.Reset()
+			Expect(transitions).To(Equal([]reddit.CircuitState{reddit.CircuitOpen, reddit.CircuitClosed}))
+		})
+
+		It("should be safe to use concurrently with Execute", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(2)
+				go func() {
+					defer wg.Done()
+					circuitBreaker.Execute(func() error { return nil })
+				}()
+				go func(i int) {
+					defer wg.Done()
+					if i%2 == 0 {
+						circuitBreaker.Trip()
+					} else {
+						circuitBreaker.Reset()
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			circuitBreaker.Reset()
+			Expect(circuitBreaker.Execute(func() error { return nil })).To(Succeed())
+		})
+	})
+
 	Describe("ShouldTrip function", func() {
 		It("should not trip circuit for errors that don't match ShouldTrip", func() {
 			config.ShouldTrip = func(err error) bool {
@@ -278,6 +414,76 @@ var _ = Describe("CircuitBreaker", func() {
 			Expect(toStates[0]).To(Equal(reddit.CircuitOpen))
 			mu.Unlock()
 		})
+
+		It("should report every transition in order as the circuit trips, times out and recovers", func() {
+			var transitions [][2]reddit.CircuitState
+			config.SuccessThreshold = 2
+			config.OnStateChange = func(from, to reddit.CircuitState) {
+				transitions = append(transitions, [2]reddit.CircuitState{from, to})
+			}
+			circuitBreaker = reddit.NewCircuitBreaker(config)
+
+			// Trip the circuit
+			for i := 0; i < config.FailureThreshold; i++ {
+				circuitBreaker.Execute(func() error {
+					return errors.New("test error")
+				})
+			}
+
+			// Time out, then fail the probe request so the circuit reopens
+			time.Sleep(config.Timeout + 10*time.Millisecond)
+			circuitBreaker.Execute(func() error {
+				return errors.New("still failing")
+			})
+
+			// Time out again and recover
+			time.Sleep(config.Timeout + 10*time.Millisecond)
+			for i := 0; i < config.SuccessThreshold; i++ {
+				Expect(circuitBreaker.Execute(func() error { return nil })).To(Succeed())
+			}
+
+			// Callbacks are delivered synchronously, so no waiting is needed
+			Expect(transitions).To(Equal([][2]reddit.CircuitState{
+				{reddit.CircuitClosed, reddit.CircuitOpen},
+				{reddit.CircuitOpen, reddit.CircuitHalfOpen},
+				{reddit.CircuitHalfOpen, reddit.CircuitOpen},
+				{reddit.CircuitOpen, reddit.CircuitHalfOpen},
+				{reddit.CircuitHalfOpen, reddit.CircuitClosed},
+			}))
+			Expect(circuitBreaker.State()).To(Equal(reddit.CircuitClosed))
+		})
+
+		It("should allow the callback to read the breaker state", func() {
+			var observed []reddit.CircuitState
+			config.OnStateChange = func(from, to reddit.CircuitState) {
+				observed = append(observed, circuitBreaker.State())
+			}
+			circuitBreaker = reddit.NewCircuitBreaker(config)
+
+			for i := 0; i < config.FailureThreshold; i++ {
+				circuitBreaker.Execute(func() error {
+					return errors.New("test error")
+				})
+			}
+
+			Expect(observed).To(Equal([]reddit.CircuitState{reddit.CircuitOpen}))
+		})
+
+		It("should transition normally without a callback", func() {
+			config.OnStateChange = nil
+			circuitBreaker = reddit.NewCircuitBreaker(config)
+
+			for i := 0; i < config.FailureThreshold; i++ {
+				circuitBreaker.Execute(func() error {
+					return errors.New("test error")
+				})
+			}
+			Expect(circuitBreaker.State()).To(Equal(reddit.CircuitOpen))
+
+			time.Sleep(config.Timeout + 10*time.Millisecond)
+			Expect(circuitBreaker.Execute(func() error { return nil })).To(Succeed())
+			Expect(circuitBreaker.State()).To(Equal(reddit.CircuitHalfOpen))
+		})
 	})
 
 	Describe("Counts", func() {
@@ -309,6 +515,51 @@ var _ = Describe("CircuitBreaker", func() {
 		})
 	})
 
+	Describe("Metrics", func() {
+		It("should count successes, failures and rejected requests", func() {
+			fail := func() error { return errors.New("test error") }
+			succeed := func() error { return nil }
+
+			Expect(circuitBreaker.Execute(succeed)).To(Succeed())
+			circuitBreaker.Execute(fail)
+			Expect(circuitBreaker.Execute(succeed)).To(Succeed())
+
+			metrics := circuitBreaker.Metrics()
+			Expect(metrics.TotalRequests).To(Equal(int64(3)))
+			Expect(metrics.TotalSuccesses).To(Equal(int64(2)))
+			Expect(metrics.TotalFailures).To(Equal(int64(1)))
+			Expect(metrics.ConsecutiveFailures).To(Equal(int64(0)))
+			Expect(metrics.State).To(Equal(reddit.CircuitClosed))
+
+			// Trip the circuit, then get rejected while it is open
+			for i := 0; i < config.FailureThreshold; i++ {
+				circuitBreaker.Execute(fail)
+			}
+			Expect(circuitBreaker.Execute(succeed)).To(HaveOccurred())
+
+			metrics = circuitBreaker.Metrics()
+			Expect(metrics.TotalRequests).To(Equal(int64(7)))
+			Expect(metrics.TotalSuccesses).To(Equal(int64(2)))
+			Expect(metrics.TotalFailures).To(Equal(int64(4)))
+			Expect(metrics.ConsecutiveFailures).To(Equal(int64(3)))
+			Expect(metrics.State).To(Equal(reddit.CircuitOpen))
+		})
+
+		It("should count failures that do not trip the circuit", func() {
+			config.ShouldTrip = func(err error) bool { return false }
+			circuitBreaker = reddit.NewCircuitBreaker(config)
+
+			for i := 0; i < 5; i++ {
+				circuitBreaker.Execute(func() error { return errors.New("client error") })
+			}
+
+			metrics := circuitBreaker.Metrics()
+			Expect(metrics.TotalFailures).To(Equal(int64(5)))
+			Expect(metrics.ConsecutiveFailures).To(Equal(int64(5)))
+			Expect(metrics.State).To(Equal(reddit.CircuitClosed))
+		})
+	})
+
 	Describe("String representation", func() {
 		It("should return a meaningful string representation", func() {
 			str := circuitBreaker.String()
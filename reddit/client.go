@@ -1,9 +1,12 @@
 package reddit
 
 import (
+	"bufio"
+	"bytes"
 	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -11,12 +14,22 @@ import (
 	"math/rand"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
-// RateLimitHook provides callbacks for rate limiting events
+// validatorKeyPrefix prefixes the cache keys under which WithConditionalRequests keeps ETags
+const validatorKeyPrefix = "etag "
+
+// defaultBaseURL is the host all API requests are sent to unless overridden with WithBaseURL
+const defaultBaseURL = "https://oauth.reddit.com"
+
+// RateLimitHook provides callbacks for rate limiting events. Embed NoopRateLimitHook to implement
+// only the callbacks of interest.
 type RateLimitHook interface {
 	// OnRateLimitWait is called when the client is waiting due to rate limits
 	OnRateLimitWait(ctx context.Context, duration time.Duration)
@@ -26,8 +39,27 @@ type RateLimitHook interface {
 
 	// OnRateLimitExceeded is called when rate limit is exceeded (remaining = 0)
 	OnRateLimitExceeded(ctx context.Context)
+
+	// OnRateLimitReconfigured is called when rate limit headers change the rate limiter's
+	// effective requests per minute and burst
+	OnRateLimitReconfigured(requestsPerMinute float64, burst int)
 }
 
+// NoopRateLimitHook is a RateLimitHook that ignores all events
+type NoopRateLimitHook struct{}
+
+// OnRateLimitWait does nothing
+func (NoopRateLimitHook) OnRateLimitWait(ctx context.Context, duration time.Duration) {}
+
+// OnRateLimitUpdate does nothing
+func (NoopRateLimitHook) OnRateLimitUpdate(remaining int, reset time.Time) {}
+
+// OnRateLimitExceeded does nothing
+func (NoopRateLimitHook) OnRateLimitExceeded(ctx context.Context) {}
+
+// OnRateLimitReconfigured does nothing
+func (NoopRateLimitHook) OnRateLimitReconfigured(requestsPerMinute float64, burst int) {}
+
 // LoggingRateLimitHook provides a default implementation that logs rate limit events using slog
 type LoggingRateLimitHook struct{}
 
@@ -52,18 +84,91 @@ func (h *LoggingRateLimitHook) OnRateLimitExceeded(ctx context.Context) {
 		"message", "API rate limit has been exceeded")
 }
 
+// OnRateLimitReconfigured logs the rate limiter's new effective rate and burst
+func (h *LoggingRateLimitHook) OnRateLimitReconfigured(requestsPerMinute float64, burst int) {
+	slog.Info("rate limiter reconfigured",
+		"requests_per_minute", requestsPerMinute,
+		"burst", burst)
+}
+
+// MetricsHook receives request metrics, such as latencies and retry counts, for export to a
+// monitoring system. The endpoint is the request path without its query string.
+//
+// A Prometheus adapter might look like:
+//
+//	type promHook struct {
+//		latency *prometheus.HistogramVec // labels: endpoint, status
+//		retries *prometheus.CounterVec   // labels: endpoint
+//		waits   prometheus.Histogram
+//	}
+//
+//	func (h promHook) ObserveRequest(endpoint string, statusCode int, duration time.Duration) {
+//		h.latency.WithLabelValues(endpoint, strconv.Itoa(statusCode)).Observe(duration.Seconds())
+//	}
+//
+//	func (h promHook) ObserveRetry(endpoint string) {
+//		h.retries.WithLabelValues(endpoint).Inc()
+//	}
+//
+//	func (h promHook) ObserveRateLimitWait(duration time.Duration) {
+//		h.waits.Observe(duration.Seconds())
+//	}
+type MetricsHook interface {
+	// ObserveRequest is called after every HTTP attempt, including retried ones, with the
+	// response status code (0 for network failures) and how long the attempt took
+	ObserveRequest(endpoint string, statusCode int, duration time.Duration)
+
+	// ObserveRetry is called each time a failed attempt is about to be retried
+	ObserveRetry(endpoint string)
+
+	// ObserveRateLimitWait is called once per request with the time spent waiting for the
+	// rate limiter, which is zero when a token was available immediately
+	ObserveRateLimitWait(duration time.Duration)
+}
+
+// NoopMetricsHook is a MetricsHook that discards all metrics. It is the default.
+type NoopMetricsHook struct{}
+
+// ObserveRequest does nothing
+func (NoopMetricsHook) ObserveRequest(endpoint string, statusCode int, duration time.Duration) {}
+
+// ObserveRetry does nothing
+func (NoopMetricsHook) ObserveRetry(endpoint string) {}
+
+// ObserveRateLimitWait does nothing
+func (NoopMetricsHook) ObserveRateLimitWait(duration time.Duration) {}
+
 // BuildEndpoint constructs a URL endpoint with query parameters using proper URL encoding
 func BuildEndpoint(base string, params map[string]string) string {
 	if len(params) == 0 {
 		return base
 	}
 
-	values := url.Values{}
+	// Encode like url.Values.Encode (keys sorted, both sides query-escaped) without building
+	// an intermediate url.Values; the key buffer stays on the stack for typical listings
+	var keyBuf [8]string
+	keys := keyBuf[:0]
+	size := len(base) + 1
 	for key, value := range params {
-		values.Set(key, value)
+		keys = append(keys, key)
+		size += len(key) + len(value) + 2
 	}
-
-	return base + "?" + values.Encode()
+	slices.Sort(keys)
+
+	var sb strings.Builder
+	sb.Grow(size)
+	sb.WriteString(base)
+	for i, key := range keys {
+		if i == 0 {
+			sb.WriteByte('?')
+		} else {
+			sb.WriteByte('&')
+		}
+		sb.WriteString(url.QueryEscape(key))
+		sb.WriteByte('=')
+		sb.WriteString(url.QueryEscape(params[key]))
+	}
+	return sb.String()
 }
 
 // RequestInterceptor is a function that can inspect and modify HTTP requests before they are sent.
@@ -71,23 +176,73 @@ func BuildEndpoint(base string, params map[string]string) string {
 // Interceptors are called in the order they are registered.
 type RequestInterceptor func(req *http.Request) error
 
+// ContextRequestInterceptor is a RequestInterceptor that also receives the context of the call
+// that issued the request, such as the ctx passed to GetPosts, making request-scoped values like
+// trace IDs easy to reach. Both kinds of interceptor are called together, in registration order.
+type ContextRequestInterceptor func(ctx context.Context, req *http.Request) error
+
 // ResponseInterceptor is a function that can inspect HTTP responses after they are received.
 // It receives the response that was received and can return an error to indicate a problem.
 // Interceptors are called in the order they are registered.
 type ResponseInterceptor func(resp *http.Response) error
 
-// Client represents a Reddit API client
+// BodyResponseInterceptor is a function that can inspect the body of successful HTTP responses.
+// It receives the response and a copy of its decompressed body, buffered by the client, so it can
+// read the body without affecting decoding. It can return an error to fail the request.
+// Interceptors are called in the order they are registered, after all ResponseInterceptors.
+type BodyResponseInterceptor func(resp *http.Response, body []byte) error
+
+// CircuitOpenInterceptor is a function that observes requests the circuit breaker rejects without
+// sending them. It receives the request that would have been sent, which has no response, and the
+// breaker's error. Request and response interceptors never run for such a request, so this is
+// where observability code records fast-fails; it runs instead of them, and after the breaker has
+// rejected the call. Interceptors are called in the order they are registered.
+type CircuitOpenInterceptor func(ctx context.Context, req *http.Request, err *CircuitBreakerError)
+
+// Client represents a Reddit API client.
+// A Client is safe for concurrent use by multiple goroutines. Its configuration is fixed once
+// NewClient returns; the state that changes while requests are in flight (the access token,
+// header-driven rate limits and circuit breaker counts) is guarded by the Auth, RateLimiter
+// and CircuitBreaker respectively.
 type Client struct {
 	Auth                 *Auth
 	userAgent            string
+	baseURL              string
 	client               *http.Client
 	rateLimiter          *RateLimiter
 	retryConfig          *RetryConfig
+	retryRand            *rand.Rand // jitter source set by WithRetryRand, nil for the global source
+	retryRandMu          sync.Mutex // guards retryRand, which is not safe for concurrent use
 	rateLimitHook        RateLimitHook
+	metricsHook          MetricsHook
+	cache                Cache
+	cacheTTL             time.Duration
+	etagMaxAge           time.Duration // how long ETags are kept for conditional requests, 0 if disabled
 	circuitBreaker       *CircuitBreaker
-	requestInterceptors  []RequestInterceptor
+	endpointBreakers     *endpointCircuitBreakers    // set by WithPerEndpointCircuitBreaker instead of circuitBreaker
+	requestInterceptors  []ContextRequestInterceptor // both kinds, in registration order
 	responseInterceptors []ResponseInterceptor
+	bodyInterceptors     []BodyResponseInterceptor
+	circuitInterceptors  []CircuitOpenInterceptor
+	defaultHeaders       http.Header // set on every request before request-specific headers
+	manualAuthorization  bool        // leave the token and Authorization header to interceptors
 	compressionEnabled   bool
+	maxResponseBytes     int64         // largest decompressed response body accepted, 0 for no limit
+	requestTimeout       time.Duration // deadline for each attempt of a request, 0 for none
+	cookieJar            http.CookieJar
+	adaptiveRateLimit    bool
+	sharedRateLimiter    bool   // rateLimiter was passed to WithSharedRateLimiter
+	removeRateLimitHook  func() // unregisters rateLimitHook from rateLimiter, set by NewClient
+	staticRateLimit      bool   // pace requests by the configured rate only, see WithDisableRateLimitHeaderUpdates
+	useJSONNumber        bool
+	rawPostData          bool         // keep each post's original data object in Post.RawData
+	rawJSON              bool         // send raw_json=1 so listing text is not HTML-escaped
+	partialOnCancel      bool         // return the items collected so far when pagination is cancelled
+	partialOnError       bool         // return the items collected so far when a page fails to load
+	requestCount         atomic.Int64 // HTTP requests sent, including retries
+	closed               atomic.Bool  // set by Close
+	logger               *slog.Logger
+	optionErrors         []error // validation errors recorded by options, reported by NewClient
 }
 
 // isRetryableStatusCode checks if a status code should trigger a retry
@@ -109,6 +264,13 @@ func (c *Client) calculateRetryDelay(attempt int, retryAfter time.Duration) time
 		return 0
 	}
 
+	if c.retryConfig.Backoff != nil {
+		if !c.retryConfig.RespectRetryAfter {
+			retryAfter = 0
+		}
+		return max(c.retryConfig.Backoff(attempt, c.retryConfig.BaseDelay, c.retryConfig.MaxDelay, retryAfter), 0)
+	}
+
 	// If Retry-After header is present and we respect it, use that
 	if retryAfter > 0 && c.retryConfig.RespectRetryAfter {
 		return retryAfter
@@ -123,14 +285,60 @@ func (c *Client) calculateRetryDelay(attempt int, retryAfter time.Duration) time
 	}
 
 	// Add jitter to prevent thundering herd
-	if c.retryConfig.JitterFactor > 0 {
-		jitter := time.Duration(float64(delay) * c.retryConfig.JitterFactor * (rand.Float64() - 0.5))
-		delay += jitter
+	switch c.retryConfig.JitterStrategy {
+	case JitterFull:
+		delay = time.Duration(float64(delay) * c.retryRandFloat64())
+	case JitterEqual:
+		half := delay / 2
+		delay = half + time.Duration(float64(delay-half)*c.retryRandFloat64())
+	default:
+		if c.retryConfig.JitterFactor > 0 {
+			jitter := time.Duration(float64(delay) * c.retryConfig.JitterFactor * (c.retryRandFloat64() - 0.5))
+			delay += jitter
+		}
+	}
+
+	// A JitterFactor above 2 could otherwise produce a negative delay
+	if delay < 0 {
+		delay = 0
 	}
 
 	return delay
 }
 
+// retryRandFloat64 returns a random number in [0, 1) from the source set by WithRetryRand,
+// or from the global source if none was set
+func (c *Client) retryRandFloat64() float64 {
+	if c.retryRand == nil {
+		return rand.Float64()
+	}
+	c.retryRandMu.Lock()
+	defer c.retryRandMu.Unlock()
+	return c.retryRand.Float64()
+}
+
+// errRetryPastDeadline is returned instead of sleeping when a retry could not start before the
+// context deadline. It matches context.DeadlineExceeded.
+var errRetryPastDeadline = fmt.Errorf("client.waitForRetry: retry delay exceeds context deadline: %w", context.DeadlineExceeded)
+
+// waitForRetry sleeps for delay before a retry. It returns ctx's error if the context ends first,
+// and errRetryPastDeadline straight away if the context deadline falls before the delay elapses,
+// since the retry would only fail with the context error after the wait.
+func waitForRetry(ctx context.Context, delay time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return errRetryPastDeadline
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // parseRetryAfter parses the Retry-After header and returns the delay duration
 func parseRetryAfter(retryAfterHeader string) time.Duration {
 	if retryAfterHeader == "" {
@@ -142,8 +350,8 @@ func parseRetryAfter(retryAfterHeader string) time.Duration {
 		return time.Duration(seconds) * time.Second
 	}
 
-	// Try parsing as HTTP date (RFC 1123)
-	if t, err := time.Parse(time.RFC1123, retryAfterHeader); err == nil {
+	// Try parsing as HTTP date (RFC 1123 and the obsolete formats HTTP/1.1 still allows)
+	if t, err := http.ParseTime(retryAfterHeader); err == nil {
 		delay := time.Until(t)
 		if delay > 0 {
 			return delay
@@ -159,8 +367,10 @@ func (c *Client) updateRateLimitFromHeaders(ctx context.Context, headers http.He
 	usedStr := headers.Get("X-Ratelimit-Used")
 	resetStr := headers.Get("X-Ratelimit-Reset")
 
-	// If no rate limit headers are present, skip update
+	// If no rate limit headers are present, skip update. An adaptive limiter has nothing
+	// to learn from, so it falls back to its configured rate.
 	if remainingStr == "" && usedStr == "" && resetStr == "" {
+		c.rateLimiter.restoreConfigured()
 		return
 	}
 
@@ -174,7 +384,7 @@ func (c *Client) updateRateLimitFromHeaders(ctx context.Context, headers http.He
 			remaining = rem
 			hasValidData = true
 		} else {
-			slog.Warn("failed to parse X-Ratelimit-Remaining header",
+			c.logger.WarnContext(ctx, "failed to parse X-Ratelimit-Remaining header",
 				"header_value", remainingStr,
 				"error", err,
 				"endpoint", endpoint)
@@ -186,7 +396,7 @@ func (c *Client) updateRateLimitFromHeaders(ctx context.Context, headers http.He
 		if u, err := strconv.Atoi(usedStr); err == nil {
 			used = u
 		} else {
-			slog.Warn("failed to parse X-Ratelimit-Used header",
+			c.logger.WarnContext(ctx, "failed to parse X-Ratelimit-Used header",
 				"header_value", usedStr,
 				"error", err,
 				"endpoint", endpoint)
@@ -199,7 +409,7 @@ func (c *Client) updateRateLimitFromHeaders(ctx context.Context, headers http.He
 			reset = time.Unix(resetInt, 0)
 			hasValidData = true
 		} else {
-			slog.Warn("failed to parse X-Ratelimit-Reset header",
+			c.logger.WarnContext(ctx, "failed to parse X-Ratelimit-Reset header",
 				"header_value", resetStr,
 				"error", err,
 				"endpoint", endpoint)
@@ -220,7 +430,7 @@ func (c *Client) updateRateLimitFromHeaders(ctx context.Context, headers http.He
 			}
 		}
 
-		slog.Debug("rate limit headers processed",
+		c.logger.DebugContext(ctx, "rate limit headers processed",
 			"remaining", remaining,
 			"used", used,
 			"reset", reset,
@@ -230,20 +440,50 @@ func (c *Client) updateRateLimitFromHeaders(ctx context.Context, headers http.He
 
 // getResponseReader returns the appropriate reader for the response body, handling compression if needed
 func (c *Client) getResponseReader(resp *http.Response) (io.ReadCloser, error) {
+	reader := resp.Body
 	if c.compressionEnabled && strings.Contains(resp.Header.Get("Content-Encoding"), "gzip") {
 		gzipReader, err := gzip.NewReader(resp.Body)
 		if err != nil {
-			return nil, fmt.Errorf("client.getResponseReader: creating gzip reader failed: %w", err)
+			return nil, fmt.Errorf("client.getResponseReader: creating gzip reader failed: %w", wrapAPIError(resp.StatusCode, "invalid gzip response", err))
 		}
 
 		// Create a composite reader that closes both gzip reader and original body
-		return &gzipReaderCloser{
+		reader = &gzipReaderCloser{
 			gzipReader: gzipReader,
 			original:   resp.Body,
-		}, nil
+		}
+	}
+
+	// Limit the decompressed size so a small compressed body cannot expand past the limit
+	if c.maxResponseBytes > 0 {
+		reader = &maxBytesReader{
+			limited:  &io.LimitedReader{R: reader, N: c.maxResponseBytes + 1},
+			original: reader,
+			max:      c.maxResponseBytes,
+		}
 	}
 
-	return resp.Body, nil
+	return reader, nil
+}
+
+// maxBytesReader reads a response body and fails with ErrResponseTooLarge once more than max
+// bytes have been read. It reads one byte past the limit so a body of exactly max bytes is accepted.
+type maxBytesReader struct {
+	limited  *io.LimitedReader
+	original io.ReadCloser
+	max      int64
+}
+
+func (m *maxBytesReader) Read(p []byte) (n int, err error) {
+	n, err = m.limited.Read(p)
+	if m.limited.N <= 0 {
+		return 0, fmt.Errorf("%w: body exceeds %d bytes", ErrResponseTooLarge, m.max)
+	}
+	return n, err
+}
+
+func (m *maxBytesReader) Close() error {
+	return m.original.Close()
 }
 
 // gzipReaderCloser wraps a gzip reader and ensures both the gzip reader and original body are closed
@@ -265,14 +505,45 @@ func (g *gzipReaderCloser) Close() error {
 	return g.original.Close()
 }
 
-// requestJSON performs an HTTP request and decodes the JSON response into the provided result
-func (c *Client) requestJSON(ctx context.Context, method, endpoint string, result any) error {
-	resp, err := c.request(ctx, method, endpoint)
+// requestJSON performs an HTTP request and decodes the JSON response into the provided result.
+// A non-nil form is sent as an application/x-www-form-urlencoded body; a nil result discards the response.
+func (c *Client) requestJSON(ctx context.Context, method, endpoint string, form url.Values, result any) error {
+	if c.closed.Load() {
+		return fmt.Errorf("client.requestJSON: %w", ErrClientClosed)
+	}
+
+	// Serve cacheable requests from the cache when possible
+	cacheKey := c.cacheKey(ctx, method, endpoint, form)
+	if cacheKey != "" {
+		if data, ok := c.cache.Get(cacheKey); ok {
+			c.logger.DebugContext(ctx, "serving response from cache", "endpoint", endpoint)
+			return c.decodeCached(method, endpoint, data, result)
+		}
+	}
+
+	// Revalidate a previously seen response instead of downloading it again
+	var header http.Header
+	var validated []byte
+	if cacheKey != "" && c.etagMaxAge > 0 {
+		if etag, body, ok := c.cachedValidator(cacheKey); ok {
+			header = http.Header{"If-None-Match": []string{etag}}
+			validated = body
+		}
+	}
+
+	resp, err := c.request(ctx, method, endpoint, form, header)
 	if err != nil {
 		return fmt.Errorf("client.requestJSON: request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified {
+		c.logger.DebugContext(ctx, "response not modified, serving cached body", "endpoint", endpoint)
+		c.cache.Set(cacheKey, validated, c.cacheTTL)
+		c.storeValidator(cacheKey, header.Get("If-None-Match"), validated)
+		return c.decodeCached(method, endpoint, validated, result)
+	}
+
 	// Get the appropriate reader (handles compression if enabled)
 	reader, err := c.getResponseReader(resp)
 	if err != nil {
@@ -280,41 +551,175 @@ func (c *Client) requestJSON(ctx context.Context, method, endpoint string, resul
 	}
 	defer reader.Close()
 
-	if err := json.NewDecoder(reader).Decode(result); err != nil {
-		return fmt.Errorf("client.requestJSON: decoding JSON response failed for %s %s: %w", method, endpoint, err)
+	// Buffer the body for body interceptors, the cache and the error envelope of write requests,
+	// then decode from the buffer
+	var body io.Reader = reader
+	var data []byte
+	write := method != http.MethodGet
+	if len(c.bodyInterceptors) > 0 || cacheKey != "" || write {
+		data, err = io.ReadAll(reader)
+		if err != nil {
+			return fmt.Errorf("client.requestJSON: reading response body failed for %s %s: %w", method, endpoint, wrapAPIError(resp.StatusCode, "reading response failed", err))
+		}
+		for i, interceptor := range c.bodyInterceptors {
+			if err := interceptor(resp, bytes.Clone(data)); err != nil {
+				return fmt.Errorf("client.requestJSON: body response interceptor %d failed: %w", i, err)
+			}
+		}
+		body = bytes.NewReader(data)
+	}
+
+	// Write endpoints report failures such as rate limiting as errors in a 200 response
+	if write {
+		if err := parseJSONErrors(resp.StatusCode, data); err != nil {
+			return fmt.Errorf("client.requestJSON: %s %s failed: %w", method, endpoint, err)
+		}
+	}
+
+	// Report a body with nothing in it before decoding, so it is not mistaken for malformed JSON
+	if result != nil {
+		buffered := bufio.NewReader(body)
+		if _, err := buffered.Peek(1); err == io.EOF {
+			return fmt.Errorf("client.requestJSON: empty response for %s %s: %w", method, endpoint, wrapAPIError(resp.StatusCode, "reading response failed", ErrEmptyResponse))
+		}
+		body = buffered
+	}
+
+	if err := c.decodeJSON(body, result); err != nil {
+		return fmt.Errorf("client.requestJSON: decoding JSON response failed for %s %s: %w", method, endpoint, wrapAPIError(resp.StatusCode, "decoding response failed", err))
+	}
+
+	// Only cache responses that decoded successfully
+	if cacheKey != "" {
+		c.cache.Set(cacheKey, data, c.cacheTTL)
+		if etag := resp.Header.Get("ETag"); etag != "" && c.etagMaxAge > 0 {
+			c.storeValidator(cacheKey, etag, data)
+		}
 	}
 
 	return nil
 }
 
+// decodeCached decodes a response body served from the cache
+func (c *Client) decodeCached(method, endpoint string, data []byte, result any) error {
+	if err := c.decodeJSON(bytes.NewReader(data), result); err != nil {
+		return fmt.Errorf("client.requestJSON: decoding cached response failed for %s %s: %w", method, endpoint, wrapAPIError(http.StatusOK, "decoding response failed", err))
+	}
+	return nil
+}
+
+// cachedValidator returns the ETag and body last stored for a cache key by storeValidator
+func (c *Client) cachedValidator(cacheKey string) (string, []byte, bool) {
+	data, ok := c.cache.Get(validatorKeyPrefix + cacheKey)
+	if !ok {
+		return "", nil, false
+	}
+	etag, rest, ok := bytes.Cut(data, []byte("\n"))
+	if !ok {
+		return "", nil, false
+	}
+	return string(etag), rest, true
+}
+
+// storeValidator keeps a response's ETag and body for the conditional request lifetime. They are
+// stored in the cache as one entry, the ETag and body separated by a newline (which an ETag can't contain).
+func (c *Client) storeValidator(cacheKey, etag string, body []byte) {
+	data := make([]byte, 0, len(etag)+1+len(body))
+	data = append(data, etag...)
+	data = append(data, '\n')
+	data = append(data, body...)
+	c.cache.Set(validatorKeyPrefix+cacheKey, data, c.etagMaxAge)
+}
+
+// decodeJSON decodes a response body into result; a nil result discards the body
+func (c *Client) decodeJSON(body io.Reader, result any) error {
+	if result == nil {
+		_, _ = io.Copy(io.Discard, body)
+		return nil
+	}
+
+	decoder := json.NewDecoder(body)
+	if c.useJSONNumber {
+		decoder.UseNumber()
+	}
+	return decoder.Decode(result)
+}
+
+// cacheKey returns the cache key for a request, or "" if the response must not be cached.
+// Only plain GET requests are cached, and never when body interceptors need to see every response
+// or the endpoint returns a different random post each time. Requests that may be altered per call,
+// by headers attached to ctx or by request interceptors, are not cached either, and the key includes
+// the identity of the Auth so responses for one user are never served to another.
+func (c *Client) cacheKey(ctx context.Context, method, endpoint string, form url.Values) string {
+	if c.cache == nil || method != http.MethodGet || form != nil || len(c.bodyInterceptors) > 0 {
+		return ""
+	}
+	if len(contextHeaders(ctx)) > 0 || len(c.requestInterceptors) > 0 {
+		return ""
+	}
+	if strings.HasSuffix(endpoint, "/random") {
+		return ""
+	}
+	return method + " " + c.Auth.cacheIdentity() + " " + endpoint
+}
+
 // request performs an HTTP request with rate limiting, retry logic, and error handling
-func (c *Client) request(ctx context.Context, method, endpoint string) (*http.Response, error) {
-	if err := c.Auth.EnsureValidToken(ctx); err != nil {
-		return nil, fmt.Errorf("client.request: ensuring valid token failed: %w", err)
+func (c *Client) request(ctx context.Context, method, endpoint string, form url.Values, header http.Header) (*http.Response, error) {
+	if !c.manualAuthorization {
+		if err := c.Auth.EnsureValidToken(ctx); err != nil {
+			return nil, fmt.Errorf("client.request: ensuring valid token failed: %w", err)
+		}
 	}
 
 	// If circuit breaker is configured, wrap the request in circuit breaker protection
-	if c.circuitBreaker != nil {
+	circuitBreaker := c.circuitBreaker
+	if c.endpointBreakers != nil {
+		circuitBreaker = c.endpointBreakers.forEndpoint(endpoint)
+	}
+	if circuitBreaker != nil {
 		var resp *http.Response
-		err := c.circuitBreaker.Execute(func() error {
+		sent := false
+		err := circuitBreaker.Execute(func() error {
+			sent = true
 			var requestErr error
-			resp, requestErr = c.performRequest(ctx, method, endpoint)
+			resp, requestErr = c.performRequest(ctx, method, endpoint, form, header)
 			return requestErr
 		})
+		var breakerErr *CircuitBreakerError
+		if !sent && errors.As(err, &breakerErr) {
+			c.interceptCircuitOpen(ctx, method, endpoint, breakerErr)
+		}
 		return resp, err
 	}
 
 	// No circuit breaker, perform request directly
-	return c.performRequest(ctx, method, endpoint)
+	return c.performRequest(ctx, method, endpoint, form, header)
+}
+
+// interceptCircuitOpen passes a request the circuit breaker rejected to the circuit open interceptors
+func (c *Client) interceptCircuitOpen(ctx context.Context, method, endpoint string, err *CircuitBreakerError) {
+	if len(c.circuitInterceptors) == 0 {
+		return
+	}
+	req, reqErr := http.NewRequestWithContext(ctx, method, c.baseURL+endpoint, nil)
+	if reqErr != nil {
+		return
+	}
+	for _, interceptor := range c.circuitInterceptors {
+		interceptor(ctx, req, err)
+	}
 }
 
 // performRequest performs the actual HTTP request with rate limiting and retry logic
-func (c *Client) performRequest(ctx context.Context, method, endpoint string) (*http.Response, error) {
+// Headers in header are added to every attempt. A 304 Not Modified response to a conditional
+// request (one with If-None-Match set) is returned like a 200.
+func (c *Client) performRequest(ctx context.Context, method, endpoint string, form url.Values, header http.Header) (*http.Response, error) {
 	// Wait for rate limit
 	if c.rateLimitHook != nil {
 		// Use Reserve to check if we need to wait
+		// When the server reported the budget exhausted, the wait lasts until its reset time
 		reservation := c.rateLimiter.Reserve()
-		delay := reservation.Delay()
+		delay := max(reservation.Delay(), c.rateLimiter.resetDelay())
 		if delay > 0 {
 			c.rateLimitHook.OnRateLimitWait(ctx, delay)
 		}
@@ -322,9 +727,17 @@ func (c *Client) performRequest(ctx context.Context, method, endpoint string) (*
 		reservation.Cancel()
 	}
 
+	waitStart := time.Now()
 	if err := c.rateLimiter.Wait(ctx); err != nil {
 		return nil, fmt.Errorf("client.performRequest: rate limit wait failed: %w", err)
 	}
+	waited := time.Since(waitStart)
+	c.metricsHook.ObserveRateLimitWait(waited)
+	if waited >= time.Millisecond {
+		c.logger.DebugContext(ctx, "waited for rate limiter", "endpoint", endpoint, "wait", waited)
+	}
+
+	metricsEndpoint, _, _ := strings.Cut(endpoint, "?")
 
 	var resp *http.Response
 	var lastError error
@@ -335,13 +748,36 @@ func (c *Client) performRequest(ctx context.Context, method, endpoint string) (*
 	}
 
 	for attempt := 0; attempt < maxAttempts; attempt++ {
-		// Create a new request for each attempt
-		req, err := http.NewRequestWithContext(ctx, method, "https://oauth.reddit.com"+endpoint, nil)
+		// Bound each attempt separately, so a slow attempt is abandoned and retried. The deadline
+		// is released when the response body is closed, as the body is read after returning.
+		attemptCtx, cancelAttempt := ctx, context.CancelFunc(func() {})
+		if c.requestTimeout > 0 {
+			attemptCtx, cancelAttempt = context.WithTimeout(ctx, c.requestTimeout)
+		}
+
+		// Create a new request for each attempt, so the body is re-read on retries
+		var reqBody io.Reader
+		if form != nil {
+			reqBody = strings.NewReader(form.Encode())
+		}
+		req, err := http.NewRequestWithContext(attemptCtx, method, c.baseURL+endpoint, reqBody)
 		if err != nil {
+			cancelAttempt()
 			return nil, fmt.Errorf("client.performRequest: creating request failed: %w", err)
 		}
+		for key, values := range c.defaultHeaders {
+			req.Header[key] = append([]string(nil), values...)
+		}
+		if form != nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
 
-		req.Header.Set("Authorization", "Bearer "+c.Auth.Token)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		if !c.manualAuthorization {
+			req.Header.Set("Authorization", "Bearer "+c.Auth.accessToken())
+		}
 		req.Header.Set("User-Agent", c.userAgent)
 
 		// Add compression header if enabled
@@ -349,42 +785,56 @@ func (c *Client) performRequest(ctx context.Context, method, endpoint string) (*
 			req.Header.Set("Accept-Encoding", "gzip")
 		}
 
+		// Add request-scoped headers from the context, keeping the client's credentials
+		for key, values := range contextHeaders(ctx) {
+			if key != "Authorization" {
+				req.Header[key] = append([]string(nil), values...)
+			}
+		}
+
 		// Call request interceptors
 		for i, interceptor := range c.requestInterceptors {
-			if err := interceptor(req); err != nil {
+			if err := interceptor(ctx, req); err != nil {
+				cancelAttempt()
 				return nil, fmt.Errorf("client.performRequest: request interceptor %d failed: %w", i, err)
 			}
 		}
 
-		slog.Debug("making HTTP request",
+		c.logger.DebugContext(ctx, "making HTTP request",
 			"method", method,
 			"endpoint", endpoint,
 			"attempt", attempt+1,
 			"max_attempts", maxAttempts)
 
+		attemptStart := time.Now()
+		c.requestCount.Add(1)
 		resp, err = c.client.Do(req)
 		if err != nil {
-			lastError = fmt.Errorf("client.performRequest: making request failed: %w", err)
+			cancelAttempt()
+			c.metricsHook.ObserveRequest(metricsEndpoint, 0, time.Since(attemptStart))
+			lastError = fmt.Errorf("client.performRequest: making request failed: %w", wrapAPIError(0, "network error", err))
 
-			// For network errors, only retry if we have retry config and attempts left
-			if c.retryConfig != nil && attempt < maxAttempts-1 {
+			// For network errors, only retry if we have retry config, attempts left, the caller hasn't
+			// given up on the request and the error is one that may succeed on another attempt
+			if c.retryConfig != nil && attempt < maxAttempts-1 && ctx.Err() == nil && c.retryConfig.shouldRetryNetworkError(err) {
 				delay := c.calculateRetryDelay(attempt, 0)
-				slog.Warn("request failed, retrying",
+				c.logger.WarnContext(ctx, "request failed, retrying",
 					"error", err,
 					"attempt", attempt+1,
 					"max_attempts", maxAttempts,
 					"delay", delay,
 					"endpoint", endpoint)
+				c.metricsHook.ObserveRetry(metricsEndpoint)
 
-				select {
-				case <-time.After(delay):
-					continue
-				case <-ctx.Done():
-					return nil, ctx.Err()
+				if err := waitForRetry(ctx, delay); err != nil {
+					return nil, err
 				}
+				continue
 			}
 			return nil, lastError
 		}
+		c.metricsHook.ObserveRequest(metricsEndpoint, resp.StatusCode, time.Since(attemptStart))
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancelAttempt}
 
 		// Call response interceptors
 		for i, interceptor := range c.responseInterceptors {
@@ -396,11 +846,14 @@ func (c *Client) performRequest(ctx context.Context, method, endpoint string) (*
 		}
 
 		// Parse and update rate limit based on response headers
-		c.updateRateLimitFromHeaders(ctx, resp.Header, endpoint)
+		if !c.staticRateLimit {
+			c.updateRateLimitFromHeaders(ctx, resp.Header, endpoint)
+		}
 
 		// Check if the response is successful
-		if resp.StatusCode == http.StatusOK {
-			slog.Debug("request successful",
+		notModified := resp.StatusCode == http.StatusNotModified && req.Header.Get("If-None-Match") != ""
+		if resp.StatusCode == http.StatusOK || notModified {
+			c.logger.DebugContext(ctx, "request successful",
 				"status_code", resp.StatusCode,
 				"endpoint", endpoint,
 				"attempt", attempt+1)
@@ -427,7 +880,7 @@ func (c *Client) performRequest(ctx context.Context, method, endpoint string) (*
 
 			lastError = NewAPIError(resp, body)
 
-			slog.Warn("received retryable error, retrying",
+			c.logger.WarnContext(ctx, "received retryable error, retrying",
 				"status_code", resp.StatusCode,
 				"error", lastError,
 				"attempt", attempt+1,
@@ -435,13 +888,12 @@ func (c *Client) performRequest(ctx context.Context, method, endpoint string) (*
 				"delay", delay,
 				"retry_after", retryAfter,
 				"endpoint", endpoint)
+			c.metricsHook.ObserveRetry(metricsEndpoint)
 
-			select {
-			case <-time.After(delay):
-				continue
-			case <-ctx.Done():
-				return nil, ctx.Err()
+			if err := waitForRetry(ctx, delay); err != nil {
+				return nil, err
 			}
+			continue
 		}
 
 		// Non-retryable error or no more attempts
@@ -465,6 +917,18 @@ func (c *Client) performRequest(ctx context.Context, method, endpoint string) (*
 	return nil, fmt.Errorf("client.performRequest: exhausted all retry attempts")
 }
 
+// cancelOnCloseBody releases an attempt's context when its response body is closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // getComments is an internal method for fetching comments
 func (c *Client) getComments(ctx context.Context, subreddit, postID string, opts ...CommentOption) ([]any, error) {
 	params := map[string]string{
@@ -476,11 +940,19 @@ func (c *Client) getComments(ctx context.Context, subreddit, postID string, opts
 		opt(params)
 	}
 
+	// Report an invalid sort before making any request
+	if sort, ok := params["sort"]; ok {
+		if err := CommentSort(sort).Valid(); err != nil {
+			return nil, fmt.Errorf("client.getComments: %w", err)
+		}
+	}
+
+	c.setListingParams(params)
 	base := fmt.Sprintf("/r/%s/comments/%s", subreddit, postID)
 	endpoint := BuildEndpoint(base, params)
 
 	var data []any
-	if err := c.requestJSON(ctx, "GET", endpoint, &data); err != nil {
+	if err := c.requestJSON(ctx, "GET", endpoint, nil, &data); err != nil {
 		return nil, fmt.Errorf("client.getComments: %w", err)
 	}
 
@@ -491,77 +963,450 @@ func (c *Client) getComments(ctx context.Context, subreddit, postID string, opts
 // This method will automatically fetch multiple pages as needed up to the specified limit.
 // Set limit to 0 to fetch all available posts (use with caution).
 func (c *Client) getPosts(ctx context.Context, subreddit string, opts ...PostOption) ([]Post, error) {
-	params := map[string]string{
-		"limit": "100", // Default limit
+	posts, _, err := c.getPostsWithPagination(ctx, subreddit, c.paginationOptions(), opts...)
+	return posts, err
+}
+
+// paginationOptions returns the default pagination options with the client's partial results policies
+func (c *Client) paginationOptions() PaginationOptions {
+	opts := DefaultPaginationOptions()
+	opts.PartialResultsOnCancel = c.partialOnCancel
+	opts.PartialResultsOnError = c.partialOnError
+	return opts
+}
+
+// getPostsWithPagination fetches posts like getPosts using the provided pagination options.
+// The limit in paginationOpts is overridden by the limit parameter of the request.
+// It also returns the after cursor to resume from: the fullname of the last post returned, or
+// an empty string once the listing is exhausted, that is when the last page that returned posts
+// reported no further page. An empty page after it does not count as exhaustion.
+func (c *Client) getPostsWithPagination(ctx context.Context, subreddit string, paginationOpts PaginationOptions, opts ...PostOption) ([]Post, string, error) {
+	pageFetcher, limit, onPost := c.postsPageFetcher(subreddit, opts...)
+	paginationOpts.Limit = limit
+
+	// Note when Reddit reports no further page so no cursor is returned for an exhausted listing
+	exhausted := false
+	fetchPage := func(ctx context.Context, after string) ([]Post, string, error) {
+		posts, nextAfter, err := pageFetcher(ctx, after)
+		if err == nil && len(posts) > 0 {
+			exhausted = nextAfter == ""
+		}
+		return posts, nextAfter, err
 	}
 
-	// Apply options
-	for _, opt := range opts {
-		opt(params)
+	resumeCursor := func(posts []Post) string {
+		if exhausted || len(posts) == 0 {
+			return ""
+		}
+		return posts[len(posts)-1].Fullname()
 	}
 
+	if onPost == nil {
+		posts, err := PaginateAll(ctx, fetchPage, paginationOpts)
+		return posts, resumeCursor(posts), err
+	}
+
+	// Run the callback as each page arrives. When it fails, end pagination after the posts
+	// already handled so they are still returned alongside the callback's error.
+	var callbackErr error
+	delivered := 0
+	fetchWithCallback := func(ctx context.Context, after string) ([]Post, string, error) {
+		posts, nextAfter, err := fetchPage(ctx, after)
+		if err != nil {
+			return nil, "", err
+		}
+		for i, post := range posts {
+			if limit > 0 && delivered >= limit {
+				break
+			}
+			if err := onPost(post); err != nil {
+				callbackErr = err
+				exhausted = false
+				return posts[:i], "", nil
+			}
+			delivered++
+		}
+		return posts, nextAfter, nil
+	}
+
+	posts, err := PaginateAll(ctx, fetchWithCallback, paginationOpts)
+	if err != nil {
+		return posts, resumeCursor(posts), err
+	}
+	if callbackErr != nil {
+		return posts, resumeCursor(posts), fmt.Errorf("client.getPostsWithPagination: post callback failed: %w", callbackErr)
+	}
+	return posts, resumeCursor(posts), nil
+}
+
+// streamPosts fetches posts page by page and emits them on a channel as they arrive.
+// With a poll interval set it then keeps polling for newer posts until ctx is cancelled.
+func (c *Client) streamPosts(ctx context.Context, subreddit string, stream StreamOptions, opts ...PostOption) (<-chan Post, <-chan error) {
+	fetchPage, limit, _ := c.postsPageFetcher(subreddit, opts...)
+	paginationOpts := stream.paginationOptions(limit)
+
+	if stream.PollInterval <= 0 {
+		return PaginateStream(ctx, fetchPage, paginationOpts)
+	}
+	return tailStream(ctx, fetchPage, c.newerPostsFetcher(subreddit, opts...), Post.Fullname, paginationOpts, stream.PollInterval)
+}
+
+// newerPostsFetcher builds the fetch function used to poll a subreddit listing for posts newer
+// than the given cursor. Each page is requested before the cursor and returns Reddit's before
+// token, which is only set while even newer posts remain.
+func (c *Client) newerPostsFetcher(subreddit string, opts ...PostOption) FetchPageFunc[Post] {
+	req := newPostRequest(opts...)
+
+	params := make(map[string]string, len(req.Params))
+	for k, v := range req.Params {
+		params[k] = v
+	}
+	delete(params, "after")
+
+	return func(ctx context.Context, cursor string) ([]Post, string, error) {
+		if cursor != "" {
+			params["before"] = cursor
+		} else {
+			delete(params, "before")
+		}
+
+		posts, before, _, err := c.getPostsPageWithCursors(ctx, subreddit, req.listing, params)
+		return posts, before, err
+	}
+}
+
+// postsPageFetcher builds the page fetch function for a subreddit listing from the given options.
+// It also returns the overall limit requested by the options (0 means no limit) and the post
+// callback set by WithPostCallback, if any.
+func (c *Client) postsPageFetcher(subreddit string, opts ...PostOption) (FetchPageFunc[Post], int, func(Post) error) {
+	req := newPostRequest(opts...)
+	params := req.Params
+
 	// Extract pagination options from params
 	limit := 0
 	if limitStr, ok := params["limit"]; ok {
 		limit, _ = strconv.Atoi(limitStr)
 	}
 
-	initialAfter := params["after"]
-
-	// Create fetch function that uses current parameters
-	fetchPage := func(ctx context.Context, after string) ([]Post, string, error) {
-		// Create a copy of params for this request
-		requestParams := make(map[string]string)
-		for k, v := range params {
-			requestParams[k] = v
-		}
+	// Before pagination walks towards newer posts. Reddit's before token on the listing is only
+	// set when there is a previous page, so the first post of each page is used as the next cursor.
+	cursorParam := "after"
+	if _, ok := params["before"]; ok {
+		cursorParam = "before"
+	}
+	initialCursor := params[cursorParam]
 
-		// Override the after parameter
-		if after != "" {
-			requestParams["after"] = after
+	// Create fetch function that uses current parameters. Pages are fetched one at a time, so a
+	// single copy of the params is reused across pages with only the cursor parameter updated.
+	requestParams := make(map[string]string, len(params))
+	for k, v := range params {
+		requestParams[k] = v
+	}
+	if cursorParam == "before" {
+		delete(requestParams, "after")
+	}
+	// Reddit numbers listing items by the count parameter, the number of items already seen, and
+	// can repeat items across pages on busy listings without it
+	fetched := 0
+	fetchPage := func(ctx context.Context, cursor string) ([]Post, string, error) {
+		// Override the cursor parameter
+		if cursor != "" {
+			requestParams[cursorParam] = cursor
 		} else {
-			// Remove after parameter if empty (for first request)
-			delete(requestParams, "after")
+			// Remove cursor parameter if empty (for first request)
+			delete(requestParams, cursorParam)
+		}
+		if fetched > 0 {
+			requestParams["count"] = strconv.Itoa(fetched)
 		}
 
-		return c.getPostsPage(ctx, subreddit, requestParams)
-	}
-
-	// Configure pagination options
-	paginationOpts := PaginationOptions{
-		Limit:       limit,
-		PageSize:    100,
-		StopOnEmpty: true,
+		posts, nextAfter, err := c.getPostsPage(ctx, subreddit, req.listing, requestParams)
+		fetched += len(posts)
+		if err != nil || cursorParam == "after" {
+			return posts, nextAfter, err
+		}
+		if len(posts) == 0 {
+			return posts, "", nil
+		}
+		return posts, posts[0].Fullname(), nil
 	}
 
-	// Handle initial after token if provided
-	if initialAfter != "" {
-		// Modify fetch function to use initial after for first call
+	// Handle initial cursor if provided
+	if initialCursor != "" {
+		// Modify fetch function to use initial cursor for first call
 		firstCall := true
 		originalFetchPage := fetchPage
-		fetchPage = func(ctx context.Context, after string) ([]Post, string, error) {
+		fetchPage = func(ctx context.Context, cursor string) ([]Post, string, error) {
 			if firstCall {
 				firstCall = false
-				return originalFetchPage(ctx, initialAfter)
+				return originalFetchPage(ctx, initialCursor)
 			}
-			return originalFetchPage(ctx, after)
+			return originalFetchPage(ctx, cursor)
 		}
 	}
 
-	return PaginateAll(ctx, fetchPage, paginationOpts)
+	// Deduplicate here rather than through PaginationOptions so post callbacks only see unique posts
+	if req.deduplicate {
+		fetchPage = deduplicatePages(fetchPage)
+	}
+
+	return fetchPage, limit, req.onPost
 }
 
-// getPostsPage fetches a single page of posts from a subreddit
-func (c *Client) getPostsPage(ctx context.Context, subreddit string, params map[string]string) ([]Post, string, error) {
+// getPostsPage fetches a single page of posts from a subreddit. A non-empty sort selects the sort
+// order's dedicated endpoint.
+func (c *Client) getPostsPage(ctx context.Context, subreddit string, sort PostSort, params map[string]string) ([]Post, string, error) {
+	posts, _, after, err := c.getPostsPageWithCursors(ctx, subreddit, sort, params)
+	return posts, after, err
+}
+
+// getPostsPageWithCursors fetches a single page of posts from a subreddit along with the listing's
+// before and after cursors
+func (c *Client) getPostsPageWithCursors(ctx context.Context, subreddit string, sort PostSort, params map[string]string) ([]Post, string, string, error) {
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, "", "", fmt.Errorf("client.getPostsPage: %w", err)
+	}
+
 	base := fmt.Sprintf("/r/%s.json", subreddit)
+	if sort != "" {
+		base = fmt.Sprintf("/r/%s/%s.json", subreddit, sort)
+	}
+	c.setListingParams(params)
 	endpoint := BuildEndpoint(base, params)
 
+	var data json.RawMessage
+	if err := c.requestJSON(ctx, "GET", endpoint, nil, &data); err != nil {
+		return nil, "", "", fmt.Errorf("client.getPostsPage: %w", err)
+	}
+
+	listing, err := DecodeListing(data, c.decodePost)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("client.getPostsPage: %w", err)
+	}
+	return listing.Items, listing.Before, listing.After, nil
+}
+
+// setListingParams adds the query parameters the client sends with every listing request
+func (c *Client) setListingParams(params map[string]string) {
+	if c.rawJSON {
+		params["raw_json"] = "1"
+	}
+}
+
+// getSubredditInfo fetches the metadata of a subreddit
+func (c *Client) getSubredditInfo(ctx context.Context, subreddit string) (*SubredditInfo, error) {
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, fmt.Errorf("client.getSubredditInfo: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/r/%s/about.json", subreddit)
+
+	var data map[string]any
+	if err := c.requestJSON(ctx, "GET", endpoint, nil, &data); err != nil {
+		return nil, fmt.Errorf("client.getSubredditInfo: %w", err)
+	}
+
+	// Reddit answers unknown subreddits with a search listing instead of a 404
+	if kind := getStringField(data, "kind"); kind != "t5" {
+		return nil, fmt.Errorf("client.getSubredditInfo: unexpected kind %q for %s: %w", kind, subreddit, ErrNotFound)
+	}
+
+	about, ok := data["data"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("client.getSubredditInfo: invalid response format missing data object")
+	}
+
+	info, err := parseSubredditInfoData(about)
+	if err != nil {
+		return nil, fmt.Errorf("client.getSubredditInfo: %w", err)
+	}
+
+	return &info, nil
+}
+
+// getRandomPost fetches a random post from a subreddit. Reddit redirects /r/{name}/random to the
+// post's comments page, which the HTTP client follows.
+func (c *Client) getRandomPost(ctx context.Context, subreddit string) (*Post, error) {
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, fmt.Errorf("client.getRandomPost: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/r/%s/random", subreddit)
+
+	var data any
+	if err := c.requestJSON(ctx, "GET", endpoint, nil, &data); err != nil {
+		return nil, fmt.Errorf("client.getRandomPost: %w", err)
+	}
+
+	// A post comes back as a [post listing, comment listing] pair like a comments page, while
+	// subreddits without posts get an empty listing object instead
+	listings, ok := data.([]any)
+	if !ok || len(listings) == 0 {
+		return nil, fmt.Errorf("client.getRandomPost: no post returned for %s: %w", subreddit, ErrNotFound)
+	}
+
+	postListing, ok := listings[0].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("client.getRandomPost: invalid response format missing post listing")
+	}
+
+	posts, _, err := parsePosts(postListing, c)
+	if err != nil {
+		return nil, fmt.Errorf("client.getRandomPost: %w", err)
+	}
+	if len(posts) == 0 {
+		return nil, fmt.Errorf("client.getRandomPost: no post returned for %s: %w", subreddit, ErrNotFound)
+	}
+
+	return &posts[0], nil
+}
+
+// getRules fetches the rules of a subreddit
+func (c *Client) getRules(ctx context.Context, subreddit string) ([]Rule, error) {
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, fmt.Errorf("client.getRules: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/r/%s/about/rules.json", subreddit)
+
+	var data struct {
+		Rules []Rule `json:"rules"`
+	}
+	if err := c.requestJSON(ctx, "GET", endpoint, nil, &data); err != nil {
+		return nil, fmt.Errorf("client.getRules: %w", err)
+	}
+
+	// Reddit answers unknown subreddits with a search listing instead of a 404. Subreddits
+	// without rules still send an empty rules array, which decodes to a non-nil slice.
+	if data.Rules == nil {
+		return nil, fmt.Errorf("client.getRules: no rules in response for %s: %w", subreddit, ErrNotFound)
+	}
+
+	return data.Rules, nil
+}
+
+// getUserList fetches all pages of one of a subreddit's user lists, such as "moderators" or
+// "contributors", from /r/{name}/about/{list}.json
+func (c *Client) getUserList(ctx context.Context, subreddit, list string) ([]UserEntry, error) {
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, fmt.Errorf("client.getUserList: %w", err)
+	}
+
+	base := fmt.Sprintf("/r/%s/about/%s.json", subreddit, list)
+	fetchPage := func(ctx context.Context, after string) ([]UserEntry, string, error) {
+		params := map[string]string{"limit": "100"}
+		if after != "" {
+			params["after"] = after
+		}
+		c.setListingParams(params)
+
+		var data struct {
+			Kind string `json:"kind"`
+			Data struct {
+				Children []UserEntry `json:"children"`
+				After    string      `json:"after"`
+			} `json:"data"`
+		}
+		if err := c.requestJSON(ctx, "GET", BuildEndpoint(base, params), nil, &data); err != nil {
+			return nil, "", err
+		}
+
+		// Reddit answers unknown subreddits with a search listing instead of a 404
+		if data.Kind != "UserList" {
+			return nil, "", fmt.Errorf("unexpected kind %q for %s: %w", data.Kind, subreddit, ErrNotFound)
+		}
+		return data.Data.Children, data.Data.After, nil
+	}
+
+	users, err := PaginateAll(ctx, fetchPage, c.paginationOptions())
+	if err != nil {
+		return users, fmt.Errorf("client.getUserList: %w", err)
+	}
+	return users, nil
+}
+
+// getWidgets fetches the sidebar and topbar widgets of a subreddit
+func (c *Client) getWidgets(ctx context.Context, subreddit string) (*Widgets, error) {
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, fmt.Errorf("client.getWidgets: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/r/%s/api/widgets", subreddit)
+
+	var data widgetsResponse
+	if err := c.requestJSON(ctx, "GET", endpoint, nil, &data); err != nil {
+		return nil, fmt.Errorf("client.getWidgets: %w", err)
+	}
+
+	widgets, err := parseWidgets(data)
+	if err != nil {
+		return nil, fmt.Errorf("client.getWidgets: %w", err)
+	}
+
+	return widgets, nil
+}
+
+// submit creates a post in a subreddit via /api/submit and returns the created post
+func (c *Client) submit(ctx context.Context, subreddit, title string, opts ...SubmitOption) (*Post, error) {
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, fmt.Errorf("client.submit: %w", err)
+	}
+
+	params := map[string]string{
+		"kind": "self", // A post without text or URL is a title-only self post
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	if params["text"] != "" && params["url"] != "" {
+		return nil, fmt.Errorf("client.submit: a post cannot have both self text and a URL: %w", ErrBadRequest)
+	}
+
+	form := url.Values{}
+	for key, value := range params {
+		form.Set(key, value)
+	}
+	form.Set("api_type", "json")
+	form.Set("sr", subreddit)
+	form.Set("title", title)
+
 	var data map[string]any
-	if err := c.requestJSON(ctx, "GET", endpoint, &data); err != nil {
-		return nil, "", fmt.Errorf("client.getPostsPage: %w", err)
+	if err := c.requestJSON(ctx, "POST", "/api/submit", form, &data); err != nil {
+		return nil, fmt.Errorf("client.submit: %w", err)
+	}
+
+	result, ok := data["json"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("client.submit: invalid response format missing json object")
 	}
 
-	return parsePosts(data, c)
+	created, ok := result["data"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("client.submit: invalid response format missing data object")
+	}
+
+	id := getStringField(created, "id")
+	if id == "" {
+		return nil, fmt.Errorf("client.submit: missing required field 'id'")
+	}
+
+	post := &Post{
+		ID:        id,
+		Title:     title,
+		Subreddit: subreddit,
+		SelfText:  params["text"],
+		URL:       getStringField(created, "url"),
+		Created:   time.Now().Unix(),
+		client:    c,
+	}
+	if params["kind"] == "link" {
+		post.URL = params["url"]
+	}
+
+	return post, nil
 }
 
 // NewClient creates a new Reddit client with the provided options
@@ -575,8 +1420,12 @@ func NewClient(auth *Auth, opts ...ClientOption) (*Client, error) {
 		Auth:               auth,
 		rateLimiter:        NewRateLimiter(60, 5), // Default to 60 requests per minute with burst of 5
 		userAgent:          "golang:reddit-client:v1.0",
+		baseURL:            defaultBaseURL,
 		client:             &http.Client{}, // Default HTTP client
 		compressionEnabled: true,           // Enable compression by default
+		partialOnCancel:    true,           // Keep what a long crawl collected when it is cancelled
+		rawJSON:            true,           // Get text fields without HTML entities such as &amp;
+		metricsHook:        NoopMetricsHook{},
 	}
 
 	// Apply options
@@ -584,15 +1433,102 @@ func NewClient(auth *Auth, opts ...ClientOption) (*Client, error) {
 		opt(c)
 	}
 
+	if c.etagMaxAge > 0 && c.cache == nil {
+		c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithConditionalRequests: requires WithCache"))
+	}
+	// Adaptive mode belongs to the limiter, so one client must not switch it on for all the
+	// clients sharing it
+	if c.adaptiveRateLimit && c.sharedRateLimiter {
+		c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithAdaptiveRateLimit: cannot be used with WithSharedRateLimiter, create the shared limiter with NewAdaptiveRateLimiter instead"))
+	}
+
+	if len(c.optionErrors) > 0 {
+		return nil, fmt.Errorf("client.NewClient: invalid options: %w", errors.Join(c.optionErrors...))
+	}
+
 	if c.client == nil {
 		c.client = &http.Client{} // Ensure we always have an HTTP client
 	}
 
-	slog.Debug("creating new client", "client", c)
+	if c.logger == nil {
+		c.logger = slog.Default()
+	}
+	// Tag logs written while handling a call with the correlation ID from its context
+	c.logger = slog.New(NewCorrelationHandler(c.logger.Handler()))
+
+	// Applied after all options so it also covers a limiter set by WithRateLimit
+	if c.adaptiveRateLimit {
+		c.rateLimiter.enableAdaptive()
+	}
+	if c.rateLimitHook != nil {
+		c.removeRateLimitHook = c.rateLimiter.addHook(c.rateLimitHook)
+	}
+
+	// Attach the cookie jar last so it survives options that replace the HTTP client. The client is
+	// copied first so an *http.Client passed to WithHTTPClient is left as the caller configured it.
+	if c.cookieJar != nil {
+		httpClient := *c.client
+		httpClient.Jar = c.cookieJar
+		c.client = &httpClient
+	}
+
+	c.logger.Debug("creating new client", "client", c)
 
 	return c, nil
 }
 
+// RequestCount returns the number of HTTP requests the client has sent to the API, counting each
+// retry attempt and requests that failed with a network error. Token requests made by Auth and
+// responses served from the cache are not counted.
+func (c *Client) RequestCount() int {
+	return int(c.requestCount.Load())
+}
+
+// ResetCircuitBreaker forces the client's circuit breaker closed, or every endpoint's breaker when
+// configured with WithPerEndpointCircuitBreaker. It does nothing if no breaker is configured.
+func (c *Client) ResetCircuitBreaker() {
+	for _, cb := range c.circuitBreakers() {
+		cb.Reset()
+	}
+}
+
+// TripCircuitBreaker forces the client's circuit breaker open, or every endpoint's breaker created
+// so far when configured with WithPerEndpointCircuitBreaker. It does nothing if no breaker is
+// configured.
+func (c *Client) TripCircuitBreaker() {
+	for _, cb := range c.circuitBreakers() {
+		cb.Trip()
+	}
+}
+
+// circuitBreakers returns the client's circuit breakers, if any
+func (c *Client) circuitBreakers() []*CircuitBreaker {
+	if c.endpointBreakers != nil {
+		return c.endpointBreakers.all()
+	}
+	if c.circuitBreaker != nil {
+		return []*CircuitBreaker{c.circuitBreaker}
+	}
+	return nil
+}
+
+// Close releases the idle keep-alive connections pooled by the client's HTTP transport and marks
+// the client closed, so later requests fail with an error wrapping ErrClientClosed. Requests
+// already in flight are not interrupted. Close is safe to call more than once; only the first
+// call has an effect. The Auth, which may be shared with other clients, is left untouched.
+func (c *Client) Close() error {
+	if c.closed.Swap(true) {
+		return nil
+	}
+	// Stop a shared limiter reporting to the hook of a client that is no longer in use
+	if c.removeRateLimitHook != nil {
+		c.removeRateLimitHook()
+	}
+	c.client.CloseIdleConnections()
+	c.logger.Debug("client closed")
+	return nil
+}
+
 // String returns a string representation of the Client struct, safely handling sensitive data
 func (c *Client) String() string {
 	if c == nil {
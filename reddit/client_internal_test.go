@@ -0,0 +1,116 @@
+package reddit
+
+import (
+	"math/rand"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fixedSource is a rand.Source whose Float64 is always value/(1<<63)
+type fixedSource struct {
+	value int64
+}
+
+func (s fixedSource) Int63() int64 { return s.value }
+func (s fixedSource) Seed(int64)   {}
+
+var _ = Describe("calculateRetryDelay", func() {
+	newRetryClient := func(config *RetryConfig, source rand.Source) *Client {
+		auth, err := NewAuth("test_id", "test_secret")
+		Expect(err).NotTo(HaveOccurred())
+		client, err := NewClient(auth, WithRetryConfig(config), WithRetryRand(rand.New(source)))
+		Expect(err).NotTo(HaveOccurred())
+		return client
+	}
+
+	retryConfig := func(strategy JitterStrategy, factor float64) *RetryConfig {
+		config := DefaultRetryConfig()
+		config.BaseDelay = time.Second
+		config.MaxDelay = 8 * time.Second
+		config.JitterStrategy = strategy
+		config.JitterFactor = factor
+		return config
+	}
+
+	const (
+		quarter      = int64(1) << 61 // Float64 of 0.25
+		threeQuarter = int64(3) << 61 // Float64 of 0.75
+	)
+
+	It("applies symmetric jitter around the backoff by default", func() {
+		client := newRetryClient(retryConfig(JitterSymmetric, 0.2), fixedSource{threeQuarter})
+
+		// 2s backoff plus 2s * 0.2 * (0.75 - 0.5)
+		Expect(client.calculateRetryDelay(1, 0)).To(Equal(2100 * time.Millisecond))
+	})
+
+	It("picks a delay between zero and the backoff with full jitter", func() {
+		client := newRetryClient(retryConfig(JitterFull, 0), fixedSource{quarter})
+
+		Expect(client.calculateRetryDelay(0, 0)).To(Equal(250 * time.Millisecond))
+		Expect(client.calculateRetryDelay(2, 0)).To(Equal(time.Second))
+		Expect(client.calculateRetryDelay(5, 0)).To(Equal(2 * time.Second)) // Capped at MaxDelay first
+	})
+
+	It("keeps half of the backoff with equal jitter", func() {
+		client := newRetryClient(retryConfig(JitterEqual, 0), fixedSource{quarter})
+
+		// 4s backoff: 2s plus 2s * 0.25
+		Expect(client.calculateRetryDelay(2, 0)).To(Equal(2500 * time.Millisecond))
+	})
+
+	It("never returns a negative delay", func() {
+		client := newRetryClient(retryConfig(JitterSymmetric, 4), fixedSource{0})
+
+		Expect(client.calculateRetryDelay(0, 0)).To(BeZero())
+	})
+
+	It("reproduces the same delays from the same seed", func() {
+		first := newRetryClient(retryConfig(JitterFull, 0), rand.NewSource(42))
+		second := newRetryClient(retryConfig(JitterFull, 0), rand.NewSource(42))
+
+		for attempt := 0; attempt < 4; attempt++ {
+			Expect(first.calculateRetryDelay(attempt, 0)).To(Equal(second.calculateRetryDelay(attempt, 0)))
+		}
+	})
+
+	It("uses Retry-After without jitter", func() {
+		client := newRetryClient(retryConfig(JitterFull, 0), fixedSource{quarter})
+
+		Expect(client.calculateRetryDelay(0, 3*time.Second)).To(Equal(3 * time.Second))
+	})
+
+	It("leaves the delay to a custom backoff strategy", func() {
+		config := retryConfig(JitterFull, 0)
+		config.Backoff = func(attempt int, baseDelay, maxDelay, retryAfter time.Duration) time.Duration {
+			return baseDelay*time.Duration(attempt+1) + retryAfter
+		}
+		client := newRetryClient(config, fixedSource{quarter})
+
+		Expect(client.calculateRetryDelay(2, 0)).To(Equal(3 * time.Second)) // No jitter applied
+		Expect(client.calculateRetryDelay(0, time.Second)).To(Equal(2 * time.Second))
+
+		config.RespectRetryAfter = false
+		Expect(client.calculateRetryDelay(0, time.Second)).To(Equal(time.Second))
+	})
+})
+
+var _ = Describe("endpointTemplate", func() {
+	It("replaces post and comment IDs with placeholders", func() {
+		Expect(endpointTemplate("/r/golang/comments/abc123")).To(Equal("/r/golang/comments/{id}"))
+		Expect(endpointTemplate("/r/golang/comments/abc123/_/def456")).To(Equal("/r/golang/comments/{id}/_/{id}"))
+		Expect(endpointTemplate("/comments/abc123.json")).To(Equal("/comments/{id}.json"))
+		Expect(endpointTemplate("/by_id/t3_abc123.json")).To(Equal("/by_id/{id}.json"))
+		Expect(endpointTemplate("/duplicates/abc123")).To(Equal("/duplicates/{id}"))
+		Expect(endpointTemplate("/user/spez/submitted.json")).To(Equal("/user/{username}/submitted.json"))
+	})
+
+	It("keeps subreddit names and other paths", func() {
+		Expect(endpointTemplate("/r/golang.json")).To(Equal("/r/golang.json"))
+		Expect(endpointTemplate("/r/golang/new.json")).To(Equal("/r/golang/new.json"))
+		Expect(endpointTemplate("/r/comments/new.json")).To(Equal("/r/comments/new.json"))
+		Expect(endpointTemplate("/api/vote")).To(Equal("/api/vote"))
+	})
+})
@@ -1,10 +1,18 @@
 package reddit
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -18,10 +26,113 @@ func WithUserAgent(userAgent string) ClientOption {
 	}
 }
 
+// WithBaseURL sets the scheme and host API requests are sent to, instead of https://oauth.reddit.com.
+// This is useful for integration testing against a local fake server, or for gateways that mirror the Reddit API.
+// The URL must be absolute; a trailing slash is ignored. An invalid URL is reported as an error by NewClient.
+//
+// Example usage:
+//
+//	client, err := reddit.NewClient(auth, reddit.WithBaseURL("http://localhost:8080"))
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		parsed, err := url.Parse(baseURL)
+		if err != nil {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithBaseURL: parsing base URL failed: %w", err))
+			return
+		}
+		if parsed.Scheme == "" || parsed.Host == "" {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithBaseURL: base URL %q must include a scheme and host", baseURL))
+			return
+		}
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithLogger sets the logger used for the client's request, retry and rate limit logging.
+// By default the client logs to slog.Default(). A nil logger is ignored.
+//
+// Example usage:
+//
+//	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+//	client, err := reddit.NewClient(auth, reddit.WithLogger(logger))
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		if logger != nil {
+			c.logger = logger
+		}
+	}
+}
+
+// WithJSONNumberMode decodes numbers in API responses as json.Number instead of float64.
+// Integer fields such as timestamps and scores are then converted exactly, avoiding the
+// precision loss float64 has for integers above 2^53.
+func WithJSONNumberMode() ClientOption {
+	return func(c *Client) {
+		c.useJSONNumber = true
+	}
+}
+
+// WithRawPostData keeps the data object Reddit sent for each post in Post.RawData, as an escape
+// hatch for fields the Post struct does not map. It is off by default to avoid holding a copy of
+// every post's JSON. The data is re-encoded from the decoded response, so combine it with
+// WithJSONNumberMode to keep large integers exact.
+func WithRawPostData() ClientOption {
+	return func(c *Client) {
+		c.rawPostData = true
+	}
+}
+
 // WithRateLimit sets custom rate limiting parameters
 func WithRateLimit(requestsPerMinute, burstSize int) ClientOption {
 	return func(c *Client) {
 		c.rateLimiter = NewRateLimiter(requestsPerMinute, burstSize)
+		c.sharedRateLimiter = false
+	}
+}
+
+// WithSharedRateLimiter makes the client wait on the given RateLimiter instead of creating its own.
+// Reddit rate-limits per OAuth app rather than per client, so clients created with the same
+// credentials (for example one per worker) should share a limiter to stay within a single budget.
+// Rate limit headers received by any of the clients update the shared limiter. The rate limit hook
+// of each client is told about these updates until the client is closed.
+//
+// Example usage:
+//
+//	limiter := reddit.NewRateLimiter(60, 5)
+//	client1, err := reddit.NewClient(auth, reddit.WithSharedRateLimiter(limiter))
+//	client2, err := reddit.NewClient(auth, reddit.WithSharedRateLimiter(limiter))
+func WithSharedRateLimiter(rl *RateLimiter) ClientOption {
+	return func(c *Client) {
+		if rl == nil {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithSharedRateLimiter: rate limiter is nil"))
+			return
+		}
+		c.rateLimiter = rl
+		c.sharedRateLimiter = true
+	}
+}
+
+// WithAdaptiveRateLimit smooths rate limit updates from the X-Ratelimit response headers.
+// Instead of applying the budget advertised by each response directly, which can oscillate
+// as the remaining count and reset time change, the limiter converges on it using an
+// exponential moving average. The rate set by WithRateLimit (or the default) is used until
+// headers arrive and whenever a response carries none.
+//
+// Adaptive mode is a property of the limiter, so it cannot be combined with WithSharedRateLimiter;
+// create the shared limiter with NewAdaptiveRateLimiter instead.
+func WithAdaptiveRateLimit() ClientOption {
+	return func(c *Client) {
+		c.adaptiveRateLimit = true
+	}
+}
+
+// WithDisableRateLimitHeaderUpdates makes the client ignore the X-Ratelimit response headers, so
+// requests are paced only by the rate set by WithRateLimit (or the default). Use it when a proxy
+// rewrites those headers incorrectly. Rate limit hooks still receive OnRateLimitWait calls, but no
+// longer receive OnRateLimitUpdate or OnRateLimitExceeded.
+func WithDisableRateLimitHeaderUpdates() ClientOption {
+	return func(c *Client) {
+		c.staticRateLimit = true
 	}
 }
 
@@ -35,6 +146,22 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// WithRequestTimeout bounds each attempt of a request, including reading its response body, by
+// timeout. Unlike WithTimeout, the deadline restarts on every retry attempt, so a slow attempt is
+// abandoned and retried (when retries are enabled) instead of using up the whole request's
+// budget. The timeout of the context passed to a method still bounds the request as a whole.
+// By default attempts have no deadline of their own. A negative timeout is reported as an error
+// by NewClient.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		if timeout < 0 {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithRequestTimeout: timeout must not be negative, got %s", timeout))
+			return
+		}
+		c.requestTimeout = timeout
+	}
+}
+
 // WithHTTPClient sets the HTTP client used for making requests.
 // This allows for complete customization of HTTP behavior including
 // transport, timeout, cookies, and redirects.
@@ -44,6 +171,27 @@ func WithHTTPClient(client *http.Client) ClientOption {
 	}
 }
 
+// WithCookieJar sets the cookie jar used by the client's HTTP client.
+// This is useful behind proxies that negotiate session cookies, without having to
+// replace the whole HTTP client via WithHTTPClient and lose the transport configuration.
+// The jar is attached after all other options are applied, so it composes with
+// WithHTTPClient and WithTransportConfig in any order. It is set on a copy of the HTTP client, so an
+// *http.Client passed to WithHTTPClient is not modified. A nil jar is reported as an error by NewClient.
+//
+// Example usage:
+//
+//	jar, _ := cookiejar.New(nil)
+//	client, err := reddit.NewClient(auth, reddit.WithCookieJar(jar))
+func WithCookieJar(jar http.CookieJar) ClientOption {
+	return func(c *Client) {
+		if jar == nil {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithCookieJar: cookie jar is required"))
+			return
+		}
+		c.cookieJar = jar
+	}
+}
+
 // WithCompression enables or disables HTTP response compression (gzip).
 // When enabled, the client will automatically add "Accept-Encoding: gzip" headers
 // to requests and decompress gzip-compressed responses transparently.
@@ -79,6 +227,96 @@ func WithNoCompression() ClientOption {
 	}
 }
 
+// WithMaxResponseBytes limits API response bodies to n bytes, guarding against a misbehaving
+// endpoint exhausting memory with an enormous response. Compressed responses are limited by
+// their decompressed size. Reading past the limit fails the request with an error wrapping
+// ErrResponseTooLarge. By default response size is not limited. A limit that is not positive is
+// reported as an error by NewClient.
+func WithMaxResponseBytes(n int64) ClientOption {
+	return func(c *Client) {
+		if n <= 0 {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithMaxResponseBytes: limit must be positive, got %d", n))
+			return
+		}
+		c.maxResponseBytes = n
+	}
+}
+
+// WithPartialResultsOnCancel sets what paginated methods such as GetPostsAfter and GetCommentsAfter
+// return when the context is cancelled or its deadline expires mid-crawl. When enabled (the
+// default), the items collected so far are returned together with an error wrapping the context
+// error. When disabled, nil is returned with the error, for all-or-nothing semantics.
+func WithPartialResultsOnCancel(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.partialOnCancel = enabled
+	}
+}
+
+// WithPartialResultsOnError makes paginated methods such as GetPostsAfter and GetCommentsAfter
+// return the items collected so far, together with the error, when a page fails to load mid-crawl,
+// for example because of a network error that outlasted the retries. By default nil is returned
+// with the error. Cancellation is governed by WithPartialResultsOnCancel instead.
+func WithPartialResultsOnError() ClientOption {
+	return func(c *Client) {
+		c.partialOnError = true
+	}
+}
+
+// WithRawJSON sets whether listing requests, such as posts, comments and the inbox, are sent with
+// raw_json=1. When enabled (the default), Reddit returns text fields such as Post.Selftext and
+// Comment.Body as written, instead of HTML-escaping characters like & as &amp;. Disable it to get
+// Reddit's escaped text as before.
+func WithRawJSON(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.rawJSON = enabled
+	}
+}
+
+// WithCache caches successful GET responses for ttl, so repeated identical requests (such as
+// polling the same listing) don't spend rate limit budget. Responses are keyed by method, the
+// identity of the Auth and endpoint, including the query string, so clients acting for different
+// users can share a cache. Requests with a body or with headers attached by ContextWithHeader, and
+// all requests when request or body response interceptors are registered, bypass the cache. Use
+// NewMemoryCache for an in-memory cache.
+func WithCache(cache Cache, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		if cache == nil {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithCache: cache is nil"))
+			return
+		}
+		if ttl <= 0 {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithCache: ttl must be positive, got %v", ttl))
+			return
+		}
+		c.cache = cache
+		c.cacheTTL = ttl
+	}
+}
+
+// WithConditionalRequests makes cached GET requests revalidate with Reddit once their cache entry
+// expires, instead of downloading the response again. The ETag and body of each response are kept
+// in the cache for maxAge, which should be longer than the cache ttl, and sent as If-None-Match;
+// a 304 Not Modified answer is served from the kept body. It requires WithCache.
+func WithConditionalRequests(maxAge time.Duration) ClientOption {
+	return func(c *Client) {
+		if maxAge <= 0 {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithConditionalRequests: max age must be positive, got %v", maxAge))
+			return
+		}
+		c.etagMaxAge = maxAge
+	}
+}
+
+// WithMetricsHook sets a hook that receives request latencies, retries and rate limit waits,
+// for example to export them to Prometheus. A nil hook is ignored.
+func WithMetricsHook(hook MetricsHook) ClientOption {
+	return func(c *Client) {
+		if hook != nil {
+			c.metricsHook = hook
+		}
+	}
+}
+
 // WithRateLimitHook sets a hook for monitoring rate limit events.
 // The hook will be called when rate limits are updated, exceeded, or when waiting.
 func WithRateLimitHook(hook RateLimitHook) ClientOption {
@@ -87,46 +325,193 @@ func WithRateLimitHook(hook RateLimitHook) ClientOption {
 	}
 }
 
-// PostOption is a function type for modifying post request parameters
-type PostOption func(params map[string]string)
+// PostOption is a function type for modifying a post listing request
+type PostOption func(req *PostRequest)
+
+// PostRequest is a post listing request as it is built by its PostOptions. Params holds the query
+// parameters sent to Reddit, which options defined outside this package may set:
+//
+//	withFlair := func(req *reddit.PostRequest) { req.Params["flair"] = "News" }
+//
+// The settings that are not sent to Reddit, such as the callback set by WithPostCallback, are
+// only set through the options of this package.
+type PostRequest struct {
+	Params      map[string]string
+	onPost      func(Post) error
+	deduplicate bool
+	listing     PostSort
+}
+
+// newPostRequest builds a post listing request from the given options
+func newPostRequest(opts ...PostOption) *PostRequest {
+	req := &PostRequest{
+		Params: map[string]string{
+			"limit": "100", // Default limit
+		},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(req)
+		}
+	}
+
+	// A sort set as a parameter, such as by an option defined outside this package, selects the
+	// listing as it does for the subreddit options, since Reddit ignores it on /r/{subreddit}.json
+	if sort, ok := req.Params["sort"]; ok {
+		if req.listing == "" {
+			req.listing = PostSort(sort)
+		}
+		delete(req.Params, "sort")
+	}
+	return req
+}
 
 // WithAfter returns a PostOption that sets the "after" parameter for pagination
 func WithAfter(after *Post) PostOption {
-	return func(params map[string]string) {
+	return func(req *PostRequest) {
 		if after != nil {
-			params["after"] = after.Fullname()
+			req.Params["after"] = after.Fullname()
+		}
+	}
+}
+
+// WithBefore returns a PostOption that sets the "before" parameter, paginating towards newer posts
+// instead of older ones. It takes precedence over WithAfter.
+func WithBefore(before *Post) PostOption {
+	return func(req *PostRequest) {
+		if before != nil {
+			req.Params["before"] = before.Fullname()
 		}
 	}
 }
 
 // WithLimit returns a PostOption that sets the "limit" parameter
 func WithLimit(limit int) PostOption {
-	return func(params map[string]string) {
+	return func(req *PostRequest) {
 		if limit > 0 {
-			params["limit"] = strconv.Itoa(limit)
+			req.Params["limit"] = strconv.Itoa(limit)
 		}
 	}
 }
 
+// WithPostCallback returns a PostOption that calls fn inline for each post, in order, as its page
+// is parsed during pagination. This lets callers start processing early pages while later ones
+// are fetched. The callback runs before the final slice is returned, and the slice is still
+// returned in full. If fn returns an error, pagination stops and the posts before the failing
+// one are returned together with that error.
+func WithPostCallback(fn func(Post) error) PostOption {
+	return func(req *PostRequest) {
+		req.onPost = fn
+	}
+}
+
+// WithDeduplication returns a PostOption that skips posts already returned earlier in the same
+// call, as with PaginationOptions.Deduplicate. Without it, a post that moves between pages while
+// they are fetched is returned once per page it appears on.
+func WithDeduplication() PostOption {
+	return func(req *PostRequest) {
+		req.deduplicate = true
+	}
+}
+
+// withPostParam returns a PostOption that sets an arbitrary query parameter
+func withPostParam(key, value string) PostOption {
+	return func(req *PostRequest) {
+		req.Params[key] = value
+	}
+}
+
+// withListing returns a PostOption that fetches posts from the dedicated endpoint of a sort order,
+// such as /r/{subreddit}/top.json, instead of passing the sort as a parameter
+func withListing(sort PostSort) PostOption {
+	return func(req *PostRequest) {
+		req.listing = sort
+	}
+}
+
+// JitterStrategy selects how randomness is added to retry backoff delays
+type JitterStrategy int
+
+const (
+	// JitterSymmetric moves the backoff up or down by up to half of JitterFactor times the backoff
+	JitterSymmetric JitterStrategy = iota
+	// JitterFull picks a delay uniformly between zero and the backoff, spreading retries out the most
+	JitterFull
+	// JitterEqual keeps half of the backoff and picks the other half uniformly at random
+	JitterEqual
+)
+
 // RetryConfig holds configuration for retry behavior
 type RetryConfig struct {
-	MaxRetries        int           // Maximum number of retry attempts (default: 3)
-	BaseDelay         time.Duration // Base delay for exponential backoff (default: 1s)
-	MaxDelay          time.Duration // Maximum delay between retries (default: 8s)
-	JitterFactor      float64       // Jitter factor to add randomness (default: 0.1)
-	RetryableCodes    []int         // HTTP status codes that should trigger retries
-	RespectRetryAfter bool          // Whether to respect Retry-After headers (default: true)
+	MaxRetries        int            // Maximum number of retry attempts (default: 3)
+	BaseDelay         time.Duration  // Base delay for exponential backoff (default: 1s)
+	MaxDelay          time.Duration  // Maximum delay between retries (default: 8s)
+	JitterFactor      float64        // Jitter factor to add randomness with JitterSymmetric (default: 0.1)
+	JitterStrategy    JitterStrategy // How jitter is applied to the backoff (default: JitterSymmetric)
+	RetryableCodes    []int          // HTTP status codes that should trigger retries
+	RespectRetryAfter bool           // Whether to respect Retry-After headers (default: true)
+
+	// ShouldRetryNetworkError decides whether a request that failed without a response is retried.
+	// When nil, DefaultShouldRetryNetworkError is used.
+	ShouldRetryNetworkError func(err error) bool
+
+	// Backoff computes the delay before each retry. When nil, the delay grows exponentially from
+	// BaseDelay up to MaxDelay with jitter applied, and a Retry-After header overrides it.
+	Backoff BackoffStrategy
+}
+
+// BackoffStrategy returns how long to wait before retry number attempt, starting at 0. It is given
+// the RetryConfig's BaseDelay and MaxDelay, and the delay requested by the response's Retry-After
+// header, or 0 if there was none or RespectRetryAfter is false. Jitter is not added to its result;
+// negative results are treated as 0.
+type BackoffStrategy func(attempt int, baseDelay, maxDelay, retryAfter time.Duration) time.Duration
+
+// DefaultShouldRetryNetworkError reports whether a network error is worth retrying.
+// Transient failures such as timeouts and connection resets are retried, while cancellation
+// and certificate or TLS handshake failures are not, since they will not succeed on a retry.
+func DefaultShouldRetryNetworkError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var (
+		recordHeaderErr tls.RecordHeaderError
+		certVerifyErr   *tls.CertificateVerificationError
+		unknownAuthErr  x509.UnknownAuthorityError
+		certInvalidErr  x509.CertificateInvalidError
+		hostnameErr     x509.HostnameError
+		tlsAlertErr     tls.AlertError
+	)
+	if errors.As(err, &recordHeaderErr) ||
+		errors.As(err, &certVerifyErr) ||
+		errors.As(err, &unknownAuthErr) ||
+		errors.As(err, &certInvalidErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &tlsAlertErr) {
+		return false
+	}
+
+	return true
+}
+
+// shouldRetryNetworkError applies the configured network error predicate, falling back to the default
+func (rc *RetryConfig) shouldRetryNetworkError(err error) bool {
+	if rc.ShouldRetryNetworkError != nil {
+		return rc.ShouldRetryNetworkError(err)
+	}
+	return DefaultShouldRetryNetworkError(err)
 }
 
 // DefaultRetryConfig returns a default retry configuration
 func DefaultRetryConfig() *RetryConfig {
 	return &RetryConfig{
-		MaxRetries:        3,
-		BaseDelay:         1 * time.Second,
-		MaxDelay:          8 * time.Second,
-		JitterFactor:      0.1,
-		RetryableCodes:    []int{429, 502, 503},
-		RespectRetryAfter: true,
+		MaxRetries:              3,
+		BaseDelay:               1 * time.Second,
+		MaxDelay:                8 * time.Second,
+		JitterFactor:            0.1,
+		RetryableCodes:          []int{429, 502, 503},
+		RespectRetryAfter:       true,
+		ShouldRetryNetworkError: DefaultShouldRetryNetworkError,
 	}
 }
 
@@ -160,6 +545,61 @@ func WithRetryDelay(baseDelay time.Duration) ClientOption {
 	}
 }
 
+// WithRetryableStatusCodes replaces the HTTP status codes that are retried, 429, 502 and 503 by
+// default. Like WithRetries, it enables retries with the default configuration if they are off.
+func WithRetryableStatusCodes(codes ...int) ClientOption {
+	return func(c *Client) {
+		if c.retryConfig == nil {
+			c.retryConfig = DefaultRetryConfig()
+		}
+		c.retryConfig.RetryableCodes = slices.Clone(codes)
+	}
+}
+
+// WithAdditionalRetryableCodes adds to the HTTP status codes that are retried, for example 500,
+// which is not retried by default. Like WithRetries, it enables retries with the default
+// configuration if they are off.
+func WithAdditionalRetryableCodes(codes ...int) ClientOption {
+	return func(c *Client) {
+		if c.retryConfig == nil {
+			c.retryConfig = DefaultRetryConfig()
+		}
+		// Copy rather than append in place, which could write into a slice shared with the caller
+		retryable := slices.Clone(c.retryConfig.RetryableCodes)
+		for _, code := range codes {
+			if !slices.Contains(retryable, code) {
+				retryable = append(retryable, code)
+			}
+		}
+		c.retryConfig.RetryableCodes = retryable
+	}
+}
+
+// WithBackoffStrategy sets how the delay before each retry is computed, replacing the default
+// exponential backoff, for example with linear, fixed or decorrelated jitter backoff. Like
+// WithRetries, it enables retries with the default configuration if they are off.
+//
+//	reddit.WithBackoffStrategy(func(attempt int, base, max, retryAfter time.Duration) time.Duration {
+//		return min(base*time.Duration(attempt+1), max) // linear
+//	})
+func WithBackoffStrategy(strategy BackoffStrategy) ClientOption {
+	return func(c *Client) {
+		if c.retryConfig == nil {
+			c.retryConfig = DefaultRetryConfig()
+		}
+		c.retryConfig.Backoff = strategy
+	}
+}
+
+// WithRetryRand sets the random source used for retry jitter, instead of the global one.
+// Passing a seeded *rand.Rand makes retry delays reproducible, e.g. in tests. The source is
+// used under a lock, so it may be shared by concurrent requests.
+func WithRetryRand(r *rand.Rand) ClientOption {
+	return func(c *Client) {
+		c.retryRand = r
+	}
+}
+
 // WithNoRetries disables retry logic
 func WithNoRetries() ClientOption {
 	return func(c *Client) {
@@ -188,6 +628,7 @@ func WithNoRetries() ClientOption {
 func WithCircuitBreaker(config *CircuitBreakerConfig) ClientOption {
 	return func(c *Client) {
 		c.circuitBreaker = NewCircuitBreaker(config)
+		c.endpointBreakers = nil
 	}
 }
 
@@ -201,6 +642,51 @@ func WithCircuitBreaker(config *CircuitBreakerConfig) ClientOption {
 func WithDefaultCircuitBreaker() ClientOption {
 	return func(c *Client) {
 		c.circuitBreaker = NewCircuitBreaker(DefaultCircuitBreakerConfig())
+		c.endpointBreakers = nil
+	}
+}
+
+// WithPerEndpointCircuitBreaker enables a separate circuit breaker for each endpoint, such as
+// /r/golang.json and /r/rust.json, so a failing endpoint fast-fails only its own requests.
+// Post, comment and user identifiers in the path are ignored, so the comments of every post in a
+// subreddit share one breaker and failures on different posts add up. Breakers are created on
+// first use with their own copy of config (the default configuration if nil); OnStateChange is
+// shared by all of them. It replaces WithCircuitBreaker, and the last of the two options applied wins.
+//
+// A breaker is kept for every subreddit requested, so prefer WithCircuitBreaker for clients that
+// request an unbounded set of subreddits.
+func WithPerEndpointCircuitBreaker(config *CircuitBreakerConfig) ClientOption {
+	return func(c *Client) {
+		c.endpointBreakers = newEndpointCircuitBreakers(config)
+		c.circuitBreaker = nil
+	}
+}
+
+// WithDefaultHeaders sets headers, such as Accept or a client version, on every request the client
+// sends. They are set first, so headers set for a single call (see ContextWithHeader) and request
+// interceptors can override them; the client's Authorization and User-Agent headers always take
+// precedence. Calling it again adds to the headers set earlier.
+func WithDefaultHeaders(headers map[string]string) ClientOption {
+	return func(c *Client) {
+		if c.defaultHeaders == nil {
+			c.defaultHeaders = make(http.Header, len(headers))
+		}
+		for key, value := range headers {
+			c.defaultHeaders.Set(key, value)
+		}
+	}
+}
+
+// WithManualAuthorization stops the client from fetching access tokens and setting the
+// Authorization header, so a request interceptor, for example one that signs requests for a proxy,
+// controls authentication entirely. The Auth passed to NewClient is then not used for requests.
+//
+// Use it with care: if no interceptor sets the header, requests are sent unauthenticated and fail
+// with 401 or 403 errors, and an interceptor that sets it is responsible for keeping its
+// credentials valid, since expired tokens are no longer refreshed.
+func WithManualAuthorization() ClientOption {
+	return func(c *Client) {
+		c.manualAuthorization = true
 	}
 }
 
@@ -217,6 +703,28 @@ func WithDefaultCircuitBreaker() ClientOption {
 //		}),
 //	)
 func WithRequestInterceptor(interceptor RequestInterceptor) ClientOption {
+	return func(c *Client) {
+		c.requestInterceptors = append(c.requestInterceptors, func(_ context.Context, req *http.Request) error {
+			return interceptor(req)
+		})
+	}
+}
+
+// WithContextRequestInterceptor adds a request interceptor that also receives the context of the
+// call that issued the request. It is called in registration order together with interceptors
+// added by WithRequestInterceptor.
+//
+// Example usage:
+//
+//	client, err := reddit.NewClient(auth,
+//		reddit.WithContextRequestInterceptor(func(ctx context.Context, req *http.Request) error {
+//			if traceID, ok := ctx.Value(traceIDKey).(string); ok {
+//				req.Header.Set("X-Trace-ID", traceID)
+//			}
+//			return nil
+//		}),
+//	)
+func WithContextRequestInterceptor(interceptor ContextRequestInterceptor) ClientOption {
 	return func(c *Client) {
 		c.requestInterceptors = append(c.requestInterceptors, interceptor)
 	}
@@ -242,6 +750,43 @@ func WithResponseInterceptor(interceptor ResponseInterceptor) ClientOption {
 	}
 }
 
+// WithBodyResponseInterceptor adds a body response interceptor to the client.
+// Body response interceptors receive a copy of each successful response's decompressed body, which
+// makes them suitable for schema validation, payload logging and response size metrics. The client
+// buffers the whole body when any are registered.
+//
+// Example usage:
+//
+//	client, err := reddit.NewClient(auth,
+//		reddit.WithBodyResponseInterceptor(func(resp *http.Response, body []byte) error {
+//			responseBytes.Observe(float64(len(body)))
+//			return nil
+//		}),
+//	)
+func WithBodyResponseInterceptor(interceptor BodyResponseInterceptor) ClientOption {
+	return func(c *Client) {
+		c.bodyInterceptors = append(c.bodyInterceptors, interceptor)
+	}
+}
+
+// WithCircuitOpenInterceptor adds an interceptor that is called for each request the circuit
+// breaker fails fast, so observability code can still count requests that never got a response.
+// It runs in place of request and response interceptors, which are skipped for such requests.
+//
+// Example usage:
+//
+//	client, err := reddit.NewClient(auth,
+//		reddit.WithDefaultCircuitBreaker(),
+//		reddit.WithCircuitOpenInterceptor(func(ctx context.Context, req *http.Request, err *reddit.CircuitBreakerError) {
+//			fastFails.WithLabelValues(req.URL.Path).Inc()
+//		}),
+//	)
+func WithCircuitOpenInterceptor(interceptor CircuitOpenInterceptor) ClientOption {
+	return func(c *Client) {
+		c.circuitInterceptors = append(c.circuitInterceptors, interceptor)
+	}
+}
+
 // TransportConfig holds configuration for HTTP transport connection pooling
 type TransportConfig struct {
 	// MaxIdleConns controls the maximum number of idle (keep-alive)
@@ -270,6 +815,16 @@ type TransportConfig struct {
 	// Zero means no limit.
 	// Default: 0 (no limit)
 	MaxConnsPerHost int
+
+	// ForceAttemptHTTP2 makes the transport try HTTP/2 even when it has a custom
+	// TLS configuration or dialer, which otherwise disables it.
+	// Default: false
+	ForceAttemptHTTP2 bool
+
+	// DisableHTTP2 restricts the transport to HTTP/1.1, for environments with
+	// intermediaries that mishandle HTTP/2. It takes precedence over ForceAttemptHTTP2.
+	// Default: false
+	DisableHTTP2 bool
 }
 
 // DefaultTransportConfig returns a default transport configuration optimized for Reddit API
@@ -280,6 +835,8 @@ func DefaultTransportConfig() *TransportConfig {
 		IdleConnTimeout:     90 * time.Second,
 		DisableKeepAlives:   false,
 		MaxConnsPerHost:     0, // No limit by default
+		ForceAttemptHTTP2:   false,
+		DisableHTTP2:        false,
 	}
 }
 
@@ -292,6 +849,7 @@ func DefaultTransportConfig() *TransportConfig {
 //   - IdleConnTimeout: 90s (Reddit's typical connection timeout)
 //   - DisableKeepAlives: false (keep-alive improves performance)
 //   - MaxConnsPerHost: 0 (no limit, let the system manage)
+//   - DisableHTTP2: false (set it only when an intermediary breaks HTTP/2)
 //
 // Example usage:
 //
@@ -325,6 +883,12 @@ func WithTransportConfig(config *TransportConfig) ClientOption {
 		transport.DisableKeepAlives = config.DisableKeepAlives
 		transport.MaxConnsPerHost = config.MaxConnsPerHost
 
+		// Apply protocol negotiation. A non-nil, empty TLSNextProto map disables HTTP/2.
+		transport.ForceAttemptHTTP2 = config.ForceAttemptHTTP2 && !config.DisableHTTP2
+		if config.DisableHTTP2 {
+			transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		}
+
 		// Ensure we have an HTTP client
 		if c.client == nil {
 			c.client = &http.Client{}
@@ -335,6 +899,44 @@ func WithTransportConfig(config *TransportConfig) ClientOption {
 	}
 }
 
+// WithProxy routes API requests through the HTTP proxy at proxyURL, for example
+// "http://proxy.internal:3128". Like WithTransportConfig, it updates a clone of the client's
+// *http.Transport (or a new transport if the client has none), so the two compose in either
+// order; pass it after WithHTTPClient, which replaces the whole client. Token requests are made
+// by Auth and need WithAuthTransport to go through the proxy. A URL without a scheme or host is
+// reported as an error by NewClient.
+func WithProxy(proxyURL string) ClientOption {
+	return func(c *Client) {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithProxy: invalid proxy URL %q: %w", proxyURL, err))
+			return
+		}
+		if parsed.Scheme == "" || parsed.Host == "" {
+			c.optionErrors = append(c.optionErrors, fmt.Errorf("client.WithProxy: proxy URL %q must include a scheme and host", proxyURL))
+			return
+		}
+
+		// Create a new transport or clone the existing one to preserve other settings
+		var transport *http.Transport
+		if c.client != nil && c.client.Transport != nil {
+			if t, ok := c.client.Transport.(*http.Transport); ok {
+				transport = t.Clone()
+			} else {
+				transport = &http.Transport{}
+			}
+		} else {
+			transport = &http.Transport{}
+		}
+		transport.Proxy = http.ProxyURL(parsed)
+
+		if c.client == nil {
+			c.client = &http.Client{}
+		}
+		c.client.Transport = transport
+	}
+}
+
 // DefaultOptions returns the default set of options
 func DefaultOptions() []ClientOption {
 	return []ClientOption{
@@ -359,7 +961,7 @@ func DefaultOptions() []ClientOption {
 //	)
 func LoggingRequestInterceptor() RequestInterceptor {
 	return func(req *http.Request) error {
-		slog.Info("outgoing HTTP request",
+		slog.InfoContext(req.Context(), "outgoing HTTP request",
 			"method", req.Method,
 			"url", req.URL.String(),
 			"user_agent", req.Header.Get("User-Agent"),
@@ -384,8 +986,12 @@ func LoggingResponseInterceptor() ResponseInterceptor {
 		} else {
 			url = "unknown"
 		}
+		ctx := context.Background()
+		if resp.Request != nil {
+			ctx = resp.Request.Context()
+		}
 
-		slog.Info("incoming HTTP response",
+		slog.InfoContext(ctx, "incoming HTTP response",
 			"status_code", resp.StatusCode,
 			"status", resp.Status,
 			"url", url,
@@ -432,14 +1038,18 @@ func DeprecationWarningResponseInterceptor() ResponseInterceptor {
 		} else {
 			url = "unknown"
 		}
+		ctx := context.Background()
+		if resp.Request != nil {
+			ctx = resp.Request.Context()
+		}
 
 		if deprecation := resp.Header.Get("X-API-Deprecated"); deprecation != "" {
-			slog.Warn("API deprecation warning",
+			slog.WarnContext(ctx, "API deprecation warning",
 				"url", url,
 				"deprecation_info", deprecation)
 		}
 		if sunset := resp.Header.Get("Sunset"); sunset != "" {
-			slog.Warn("API sunset warning",
+			slog.WarnContext(ctx, "API sunset warning",
 				"url", url,
 				"sunset_date", sunset)
 		}
@@ -465,3 +1075,32 @@ func RequestIDRequestInterceptor(headerName string) RequestInterceptor {
 		return nil
 	}
 }
+
+// SchemaValidationResponseInterceptor returns a body response interceptor that passes each
+// successful response body to validate and fails the request with its error. This is useful for
+// rejecting responses that don't match the shape the caller expects, so changes to the Reddit API
+// are caught where they happen rather than as missing fields later on.
+//
+// Example usage:
+//
+//	client, err := reddit.NewClient(auth,
+//		reddit.WithBodyResponseInterceptor(reddit.SchemaValidationResponseInterceptor(func(body []byte) error {
+//			var listing struct{ Kind string }
+//			if err := json.Unmarshal(body, &listing); err != nil || listing.Kind == "" {
+//				return errors.New("response is not a Reddit thing")
+//			}
+//			return nil
+//		})),
+//	)
+func SchemaValidationResponseInterceptor(validate func(body []byte) error) BodyResponseInterceptor {
+	return func(resp *http.Response, body []byte) error {
+		if err := validate(body); err != nil {
+			url := "unknown"
+			if resp.Request != nil && resp.Request.URL != nil {
+				url = resp.Request.URL.String()
+			}
+			return fmt.Errorf("response from %s failed schema validation: %w", url, err)
+		}
+		return nil
+	}
+}
@@ -1,7 +1,16 @@
 package reddit_test
 
 import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
 	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/JohnPlummer/reddit-client/reddit"
@@ -251,6 +260,359 @@ var _ = Describe("Client Options", func() {
 		})
 	})
 
+	Describe("WithSharedRateLimiter", func() {
+		It("holds the combined request rate of several clients to one budget", func() {
+			transport := &listingTransport{}
+			auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+			Expect(err).NotTo(HaveOccurred())
+
+			// 600 requests per minute is one request every 100ms
+			limiter := reddit.NewRateLimiter(600, 1)
+			var subreddits []*reddit.Subreddit
+			for i := 0; i < 2; i++ {
+				client, err := reddit.NewClient(auth,
+					reddit.WithHTTPClient(&http.Client{Transport: transport}),
+					reddit.WithSharedRateLimiter(limiter))
+				Expect(err).NotTo(HaveOccurred())
+				subreddits = append(subreddits, reddit.NewSubreddit("golang", client))
+			}
+
+			const requestsPerClient = 3
+			start := time.Now()
+			var wg sync.WaitGroup
+			for _, subreddit := range subreddits {
+				wg.Add(1)
+				go func(subreddit *reddit.Subreddit) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					for i := 0; i < requestsPerClient; i++ {
+						_, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+						Expect(err).NotTo(HaveOccurred())
+					}
+				}(subreddit)
+			}
+			wg.Wait()
+
+			// Six requests through one limiter need at least five 100ms intervals,
+			// whereas two independent limiters would finish in about half that
+			Expect(transport.requests.Load()).To(Equal(int32(2 * requestsPerClient)))
+			Expect(time.Since(start)).To(BeNumerically(">=", 450*time.Millisecond))
+		})
+
+		It("returns an error for a nil limiter", func() {
+			client, err := reddit.NewClient(auth, reddit.WithSharedRateLimiter(nil))
+			Expect(err).To(MatchError(ContainSubstring("rate limiter is nil")))
+			Expect(client).To(BeNil())
+		})
+
+		It("rejects WithAdaptiveRateLimit, which would change the limiter of every client", func() {
+			limiter := reddit.NewRateLimiter(600, 5)
+			client, err := reddit.NewClient(auth,
+				reddit.WithSharedRateLimiter(limiter),
+				reddit.WithAdaptiveRateLimit())
+			Expect(err).To(MatchError(ContainSubstring("cannot be used with WithSharedRateLimiter")))
+			Expect(client).To(BeNil())
+		})
+
+		It("stops telling the rate limit hook of a closed client about updates", func() {
+			limiter := reddit.NewRateLimiter(600, 5)
+			closedHook := &reconfiguredOnlyHook{}
+			openHook := &reconfiguredOnlyHook{}
+			closed, err := reddit.NewClient(auth,
+				reddit.WithSharedRateLimiter(limiter),
+				reddit.WithRateLimitHook(closedHook))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = reddit.NewClient(auth,
+				reddit.WithSharedRateLimiter(limiter),
+				reddit.WithRateLimitHook(openHook))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(closed.Close()).To(Succeed())
+			limiter.UpdateLimitWithUsed(30, 0, time.Now().Add(time.Minute))
+
+			Expect(closedHook.bursts).To(BeEmpty())
+			Expect(openHook.bursts).To(Equal([]int{3}))
+		})
+	})
+
+	Describe("WithAdaptiveRateLimit", func() {
+		listingWithHeaders := func(remaining string) *http.Response {
+			resp := reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}},
+			})
+			resp.Header = make(http.Header)
+			if remaining != "" {
+				resp.Header.Set("X-Ratelimit-Remaining", remaining)
+				resp.Header.Set("X-Ratelimit-Reset", strconv.FormatInt(time.Now().Add(60*time.Second).Unix(), 10))
+			}
+			return resp
+		}
+
+		It("smooths header updates and falls back to the configured rate without headers", func() {
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: transport}),
+				reddit.WithRateLimit(600, 5),
+				reddit.WithAdaptiveRateLimit())
+			Expect(err).NotTo(HaveOccurred())
+			subreddit := reddit.NewSubreddit("golang", client)
+
+			// The headers advertise 60 requests per minute; the limiter only moves part of the way there
+			transport.AddResponseToQueue("/r/golang.json", listingWithHeaders("60"))
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.String()).To(ContainSubstring("requests_per_minute: 43"))
+
+			transport.AddResponseToQueue("/r/golang.json", listingWithHeaders(""))
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.String()).To(ContainSubstring("requests_per_minute: 600.0"))
+		})
+	})
+
+	Describe("WithDisableRateLimitHeaderUpdates", func() {
+		It("keeps the configured rate when responses carry rate limit headers", func() {
+			limiter := reddit.NewRateLimiter(600, 5)
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: transport}),
+				reddit.WithSharedRateLimiter(limiter),
+				reddit.WithDisableRateLimitHeaderUpdates())
+			Expect(err).NotTo(HaveOccurred())
+
+			resp := reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}},
+			})
+			resp.Header = make(http.Header)
+			resp.Header.Set("X-Ratelimit-Remaining", "1")
+			resp.Header.Set("X-Ratelimit-Used", "599")
+			resp.Header.Set("X-Ratelimit-Reset", strconv.FormatInt(time.Now().Add(60*time.Second).Unix(), 10))
+			transport.AddResponse("/r/golang.json", resp)
+
+			_, err = reddit.NewSubreddit("golang", client).GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			requestsPerMinute, burst := limiter.GetConfig()
+			Expect(requestsPerMinute).To(BeNumerically("~", 600, 0.01))
+			Expect(burst).To(Equal(5))
+		})
+	})
+
+	Describe("WithJSONNumberMode", func() {
+		const listing = `{"data": {"children": [{"data": {"id": "abc123", "title": "Post", "created_utc": 9007199254740993}}]}}`
+
+		addListing := func() {
+			transport.AddResponse("/r/golang.json", &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(listing)),
+			})
+		}
+
+		It("preserves integers that float64 cannot represent", func() {
+			addListing()
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: transport}),
+				reddit.WithJSONNumberMode())
+			Expect(err).NotTo(HaveOccurred())
+
+			posts, err := reddit.NewSubreddit("golang", client).GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			Expect(posts[0].Created).To(Equal(int64(9007199254740993)))
+		})
+
+		It("loses precision without the option", func() {
+			addListing()
+			client, err := reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: transport}))
+			Expect(err).NotTo(HaveOccurred())
+
+			posts, err := reddit.NewSubreddit("golang", client).GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts[0].Created).NotTo(Equal(int64(9007199254740993)))
+		})
+	})
+
+	Describe("WithRawPostData", func() {
+		const listing = `{"data": {"children": [{"data": {"id": "abc123", "title": "Post", "link_flair_richtext": [{"t": "News"}], "created_utc": 9007199254740993}}]}}`
+
+		getPost := func(opts ...reddit.ClientOption) reddit.Post {
+			transport.AddResponse("/r/golang.json", &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(listing)),
+			})
+			opts = append([]reddit.ClientOption{reddit.WithHTTPClient(&http.Client{Transport: transport})}, opts...)
+			client, err := reddit.NewClient(auth, opts...)
+			Expect(err).NotTo(HaveOccurred())
+
+			posts, err := reddit.NewSubreddit("golang", client).GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			return posts[0]
+		}
+
+		It("keeps the original data object of each post", func() {
+			post := getPost(reddit.WithRawPostData(), reddit.WithJSONNumberMode())
+			Expect(post.RawData).To(MatchJSON(`{"id": "abc123", "title": "Post", "link_flair_richtext": [{"t": "News"}], "created_utc": 9007199254740993}`))
+		})
+
+		It("leaves RawData empty without the option", func() {
+			post := getPost()
+			Expect(post.RawData).To(BeNil())
+		})
+	})
+
+	Describe("WithRawJSON", func() {
+		getPost := func(opts ...reddit.ClientOption) (reddit.Post, string) {
+			transport := &escapingTransport{}
+			opts = append([]reddit.ClientOption{reddit.WithHTTPClient(&http.Client{Transport: transport})}, opts...)
+			client, err := reddit.NewClient(auth, opts...)
+			Expect(err).NotTo(HaveOccurred())
+
+			posts, err := reddit.NewSubreddit("golang", client).GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			return posts[0], transport.query
+		}
+
+		It("sends raw_json=1 by default so text comes back unescaped", func() {
+			post, query := getPost()
+			Expect(query).To(ContainSubstring("raw_json=1"))
+			Expect(post.SelfText).To(Equal("Q&A"))
+		})
+
+		It("leaves the text escaped when disabled", func() {
+			post, query := getPost(reddit.WithRawJSON(false))
+			Expect(query).NotTo(ContainSubstring("raw_json"))
+			Expect(post.SelfText).To(Equal("Q&amp;A"))
+		})
+	})
+
+	Describe("WithLogger", func() {
+		It("emits client logs to the injected logger", func() {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}},
+			}))
+
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: transport}),
+				reddit.WithLogger(logger))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reddit.NewSubreddit("golang", client).GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(buf.String()).To(ContainSubstring("creating new client"))
+			Expect(buf.String()).To(ContainSubstring("making HTTP request"))
+			Expect(buf.String()).To(ContainSubstring("/r/golang.json"))
+		})
+
+		It("ignores a nil logger", func() {
+			client, err := reddit.NewClient(auth, reddit.WithLogger(nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client).NotTo(BeNil())
+		})
+	})
+
+	Describe("WithBaseURL", func() {
+		var requestedURLs []string
+
+		BeforeEach(func() {
+			requestedURLs = nil
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}},
+			}))
+		})
+
+		capture := func(req *http.Request) error {
+			requestedURLs = append(requestedURLs, req.URL.Scheme+"://"+req.URL.Host+req.URL.Path)
+			return nil
+		}
+
+		It("sends requests to the configured host", func() {
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: transport}),
+				reddit.WithBaseURL("http://localhost:8080/"),
+				reddit.WithRequestInterceptor(capture))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reddit.NewSubreddit("golang", client).GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requestedURLs).To(Equal([]string{"http://localhost:8080/r/golang.json"}))
+		})
+
+		It("defaults to the Reddit OAuth host", func() {
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: transport}),
+				reddit.WithRequestInterceptor(capture))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reddit.NewSubreddit("golang", client).GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requestedURLs).To(Equal([]string{"https://oauth.reddit.com/r/golang.json"}))
+		})
+
+		It("returns an error for a URL without scheme and host", func() {
+			client, err := reddit.NewClient(auth, reddit.WithBaseURL("localhost:8080"))
+			Expect(err).To(MatchError(ContainSubstring("must include a scheme and host")))
+			Expect(client).To(BeNil())
+		})
+	})
+
+	Describe("WithCookieJar", func() {
+		It("attaches the jar regardless of option order without modifying the caller's HTTP client", func() {
+			jar, err := cookiejar.New(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp := reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}},
+			})
+			resp.Header = http.Header{"Set-Cookie": []string{"session=abc123; Path=/"}}
+			transport.AddResponse("/r/golang.json", resp)
+			customClient := &http.Client{Transport: transport}
+
+			client, err := reddit.NewClient(auth,
+				reddit.WithCookieJar(jar),
+				reddit.WithHTTPClient(customClient))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(customClient.Jar).To(BeNil())
+
+			_, err = reddit.NewSubreddit("golang", client).GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(jar.Cookies(&url.URL{Scheme: "https", Host: "oauth.reddit.com", Path: "/"})).To(HaveLen(1))
+		})
+
+		It("stores cookies set by responses", func() {
+			jar, err := cookiejar.New(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp := reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}},
+			})
+			resp.Header = http.Header{"Set-Cookie": []string{"session=abc123; Path=/"}}
+			transport.AddResponse("/r/golang.json", resp)
+
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: transport}),
+				reddit.WithCookieJar(jar))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reddit.NewSubreddit("golang", client).GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			cookies := jar.Cookies(&url.URL{Scheme: "https", Host: "oauth.reddit.com", Path: "/"})
+			Expect(cookies).To(HaveLen(1))
+			Expect(cookies[0].Name).To(Equal("session"))
+			Expect(cookies[0].Value).To(Equal("abc123"))
+		})
+
+		It("returns an error for a nil jar", func() {
+			client, err := reddit.NewClient(auth, reddit.WithCookieJar(nil))
+			Expect(err).To(MatchError(ContainSubstring("cookie jar is required")))
+			Expect(client).To(BeNil())
+		})
+	})
+
 	Describe("WithTransportConfig", func() {
 		It("applies default transport configuration", func() {
 			client, err := reddit.NewClient(auth,
@@ -423,6 +785,88 @@ var _ = Describe("Client Options", func() {
 		})
 	})
 
+	Describe("WithTransportConfig protocol negotiation", func() {
+		It("applies ForceAttemptHTTP2 from the config", func() {
+			for _, force := range []bool{true, false} {
+				config := reddit.DefaultTransportConfig()
+				config.ForceAttemptHTTP2 = force
+
+				customClient := &http.Client{}
+				_, err := reddit.NewClient(auth,
+					reddit.WithHTTPClient(customClient),
+					reddit.WithTransportConfig(config))
+				Expect(err).NotTo(HaveOccurred())
+
+				transport := customClient.Transport.(*http.Transport)
+				Expect(transport.ForceAttemptHTTP2).To(Equal(force))
+				Expect(transport.TLSNextProto).To(BeNil())
+			}
+		})
+
+		It("restricts the transport to HTTP/1.1 when HTTP/2 is disabled", func() {
+			config := reddit.DefaultTransportConfig()
+			config.ForceAttemptHTTP2 = true
+			config.DisableHTTP2 = true
+
+			customClient := &http.Client{}
+			_, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(customClient),
+				reddit.WithTransportConfig(config))
+			Expect(err).NotTo(HaveOccurred())
+
+			transport := customClient.Transport.(*http.Transport)
+			Expect(transport.ForceAttemptHTTP2).To(BeFalse())
+			Expect(transport.TLSNextProto).NotTo(BeNil())
+			Expect(transport.TLSNextProto).To(BeEmpty())
+		})
+	})
+
+	Describe("WithProxy", func() {
+		It("routes requests through the configured proxy", func() {
+			customClient := &http.Client{}
+			_, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(customClient),
+				reddit.WithProxy("http://proxy.internal:3128"))
+			Expect(err).NotTo(HaveOccurred())
+
+			transport, ok := customClient.Transport.(*http.Transport)
+			Expect(ok).To(BeTrue())
+
+			req, err := http.NewRequest(http.MethodGet, "https://oauth.reddit.com/r/golang.json", nil)
+			Expect(err).NotTo(HaveOccurred())
+			proxyURL, err := transport.Proxy(req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyURL.String()).To(Equal("http://proxy.internal:3128"))
+		})
+
+		It("composes with WithTransportConfig in either order", func() {
+			config := reddit.DefaultTransportConfig()
+			config.MaxIdleConnsPerHost = 25
+
+			for _, opts := range [][]reddit.ClientOption{
+				{reddit.WithProxy("http://proxy.internal:3128"), reddit.WithTransportConfig(config)},
+				{reddit.WithTransportConfig(config), reddit.WithProxy("http://proxy.internal:3128")},
+			} {
+				customClient := &http.Client{}
+				_, err := reddit.NewClient(auth, append([]reddit.ClientOption{reddit.WithHTTPClient(customClient)}, opts...)...)
+				Expect(err).NotTo(HaveOccurred())
+
+				transport := customClient.Transport.(*http.Transport)
+				Expect(transport.MaxIdleConnsPerHost).To(Equal(25))
+				Expect(transport.Proxy).NotTo(BeNil())
+			}
+		})
+
+		It("returns an error for an invalid proxy URL", func() {
+			client, err := reddit.NewClient(auth, reddit.WithProxy("proxy.internal:3128"))
+			Expect(err).To(MatchError(ContainSubstring("client.WithProxy")))
+			Expect(client).To(BeNil())
+
+			_, err = reddit.NewClient(auth, reddit.WithProxy("http://%zz"))
+			Expect(err).To(MatchError(ContainSubstring("invalid proxy URL")))
+		})
+	})
+
 	Describe("DefaultTransportConfig", func() {
 		It("returns sensible defaults for Reddit API", func() {
 			config := reddit.DefaultTransportConfig()
@@ -432,6 +876,8 @@ var _ = Describe("Client Options", func() {
 			Expect(config.IdleConnTimeout).To(Equal(90 * time.Second))
 			Expect(config.DisableKeepAlives).To(BeFalse())
 			Expect(config.MaxConnsPerHost).To(Equal(0))
+			Expect(config.ForceAttemptHTTP2).To(BeFalse())
+			Expect(config.DisableHTTP2).To(BeFalse())
 		})
 
 		It("can be modified before using", func() {
@@ -513,3 +959,24 @@ func (m *MockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		Body:       http.NoBody,
 	}, nil
 }
+
+// escapingTransport answers listing requests like Reddit, HTML-escaping text unless raw_json=1 is
+// set, and records the query of the last request
+type escapingTransport struct {
+	query string
+}
+
+func (e *escapingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	e.query = req.URL.RawQuery
+	selftext := "Q&amp;A"
+	if req.URL.Query().Get("raw_json") == "1" {
+		selftext = "Q&A"
+	}
+	return reddit.CreateJSONResponse(map[string]any{
+		"data": map[string]any{
+			"children": []any{
+				map[string]any{"data": map[string]any{"id": "abc123", "title": "Post", "selftext": selftext}},
+			},
+		},
+	}), nil
+}
@@ -4,11 +4,23 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
 	"time"
 
 	"github.com/JohnPlummer/reddit-client/reddit"
@@ -228,6 +240,72 @@ var _ = Describe("Client", func() {
 				Expect(golangCalls).To(Equal(2)) // 2 attempts to /r/golang.json
 			})
 
+			It("returns the context error without sleeping when the retry delay outlasts the deadline", func() {
+				var err error
+				client, err = reddit.NewClient(auth,
+					reddit.WithHTTPClient(mockClient),
+					reddit.WithRetries(2),
+					reddit.WithRetryDelay(time.Minute),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				subreddit = reddit.NewSubreddit("golang", client)
+
+				transport.AddResponseToQueue("/r/golang.json", &http.Response{
+					StatusCode: 503,
+					Body:       http.NoBody,
+				})
+
+				ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+				defer cancel()
+
+				start := time.Now()
+				_, err = subreddit.GetPosts(ctx)
+				Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+				Expect(time.Since(start)).To(BeNumerically("<", 500*time.Millisecond))
+				Expect(ctx.Err()).To(BeNil()) // Returned before the deadline was reached
+			})
+
+			It("waits for the Retry-After delay of a 503", func() {
+				transport.AddResponseToQueue("/r/golang.json", &http.Response{
+					StatusCode: 503,
+					Body:       http.NoBody,
+					Header:     http.Header{"Retry-After": []string{"1"}},
+				})
+				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{},
+						"after":    nil,
+					},
+				}))
+
+				start := time.Now()
+				_, err := subreddit.GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				// The configured retry delay is 100ms; the header asks for a second
+				Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+			})
+
+			It("surfaces Retry-After on the error when the 503 is not retried", func() {
+				var err error
+				client, err = reddit.NewClient(auth,
+					reddit.WithHTTPClient(mockClient),
+					reddit.WithNoRetries(),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				subreddit = reddit.NewSubreddit("golang", client)
+
+				transport.AddResponseToQueue("/r/golang.json", &http.Response{
+					StatusCode: 503,
+					Body:       http.NoBody,
+					Header:     http.Header{"Retry-After": []string{"120"}},
+				})
+
+				_, err = subreddit.GetPosts(context.Background())
+				var apiErr *reddit.APIError
+				Expect(errors.As(err, &apiErr)).To(BeTrue())
+				Expect(apiErr.RetryAfter).To(Equal(2 * time.Minute))
+			})
+
 			It("exhausts retries and returns the last error", func() {
 				// All requests return 429
 				for i := 0; i < 3; i++ {
@@ -254,6 +332,111 @@ var _ = Describe("Client", func() {
 			})
 		})
 
+		Context("with customized retryable status codes", func() {
+			countCalls := func() int {
+				calls := 0
+				for _, call := range transport.GetCallHistory() {
+					if strings.Contains(call, "/r/golang.json") {
+						calls++
+					}
+				}
+				return calls
+			}
+
+			It("retries a 500 once it is added to the retryable codes", func() {
+				var err error
+				client, err = reddit.NewClient(auth,
+					reddit.WithHTTPClient(mockClient),
+					reddit.WithRetries(2),
+					reddit.WithRetryDelay(time.Millisecond),
+					reddit.WithAdditionalRetryableCodes(500),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				subreddit = reddit.NewSubreddit("golang", client)
+
+				transport.AddResponseToQueue("/r/golang.json", &http.Response{StatusCode: 500, Body: http.NoBody})
+				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{"children": []any{}, "after": nil},
+				}))
+
+				_, err = subreddit.GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(countCalls()).To(Equal(2))
+			})
+
+			It("keeps the default codes when adding to them", func() {
+				var err error
+				client, err = reddit.NewClient(auth,
+					reddit.WithHTTPClient(mockClient),
+					reddit.WithRetryDelay(time.Millisecond),
+					reddit.WithAdditionalRetryableCodes(500, 429),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				subreddit = reddit.NewSubreddit("golang", client)
+
+				transport.AddResponseToQueue("/r/golang.json", &http.Response{StatusCode: 502, Body: http.NoBody})
+				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{"children": []any{}, "after": nil},
+				}))
+
+				_, err = subreddit.GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(countCalls()).To(Equal(2))
+			})
+
+			It("only retries the codes given to WithRetryableStatusCodes", func() {
+				var err error
+				client, err = reddit.NewClient(auth,
+					reddit.WithHTTPClient(mockClient),
+					reddit.WithRetryDelay(time.Millisecond),
+					reddit.WithRetryableStatusCodes(500),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				subreddit = reddit.NewSubreddit("golang", client)
+
+				transport.AddResponseToQueue("/r/golang.json", &http.Response{StatusCode: 502, Body: http.NoBody})
+
+				_, err = subreddit.GetPosts(context.Background())
+				Expect(err).To(HaveOccurred())
+				Expect(countCalls()).To(Equal(1))
+			})
+		})
+
+		Context("with a custom backoff strategy", func() {
+			It("spaces retries by the strategy's delay", func() {
+				var attempts []int
+				timing := &timingTransport{next: transport}
+				var err error
+				client, err = reddit.NewClient(auth,
+					reddit.WithHTTPClient(&http.Client{Transport: timing}),
+					reddit.WithRetries(2),
+					reddit.WithBackoffStrategy(func(attempt int, baseDelay, maxDelay, retryAfter time.Duration) time.Duration {
+						attempts = append(attempts, attempt)
+						return 50 * time.Millisecond
+					}),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				subreddit = reddit.NewSubreddit("golang", client)
+
+				transport.AddResponseToQueue("/r/golang.json", &http.Response{StatusCode: 503, Body: http.NoBody})
+				transport.AddResponseToQueue("/r/golang.json", &http.Response{StatusCode: 503, Body: http.NoBody})
+				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{"children": []any{}, "after": nil},
+				}))
+
+				_, err = subreddit.GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(attempts).To(Equal([]int{0, 1}))
+
+				Expect(timing.times).To(HaveLen(3))
+				for i := 1; i < len(timing.times); i++ {
+					gap := timing.times[i].Sub(timing.times[i-1])
+					Expect(gap).To(BeNumerically(">=", 50*time.Millisecond))
+					Expect(gap).To(BeNumerically("<", 500*time.Millisecond))
+				}
+			})
+		})
+
 		Context("when receiving non-retryable errors", func() {
 			It("does not retry on 404 (not found)", func() {
 				nonexistentSubreddit := reddit.NewSubreddit("nonexistent", client)
@@ -403,6 +586,139 @@ var _ = Describe("Client", func() {
 				Expect(errors.Is(err, context.Canceled)).To(BeTrue())
 			})
 		})
+
+		Context("when receiving network errors", func() {
+			emptyListing := func() *http.Response {
+				return reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{},
+						"after":    nil,
+					},
+				})
+			}
+
+			countGolangCalls := func() int {
+				golangCalls := 0
+				for _, call := range transport.GetCallHistory() {
+					if strings.Contains(call, "/r/golang.json") {
+						golangCalls++
+					}
+				}
+				return golangCalls
+			}
+
+			It("retries a timeout and succeeds", func() {
+				// Call 1 is authentication, call 2 is the first attempt
+				transport.SetErrorOnCall(2, &net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded})
+				transport.AddResponse("/r/golang.json", emptyListing())
+
+				_, err := subreddit.GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(countGolangCalls()).To(Equal(2))
+			})
+
+			It("does not retry a cancelled request", func() {
+				transport.SetErrorOnCall(2, context.Canceled)
+				transport.AddResponse("/r/golang.json", emptyListing())
+
+				_, err := subreddit.GetPosts(context.Background())
+				Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+				Expect(countGolangCalls()).To(Equal(1))
+			})
+
+			It("does not retry a certificate error", func() {
+				transport.SetErrorOnCall(2, x509.UnknownAuthorityError{})
+				transport.AddResponse("/r/golang.json", emptyListing())
+
+				_, err := subreddit.GetPosts(context.Background())
+				Expect(err).To(HaveOccurred())
+				Expect(countGolangCalls()).To(Equal(1))
+			})
+
+			It("uses a custom ShouldRetryNetworkError predicate", func() {
+				config := reddit.DefaultRetryConfig()
+				config.BaseDelay = 10 * time.Millisecond
+				config.ShouldRetryNetworkError = func(err error) bool { return false }
+
+				var err error
+				client, err = reddit.NewClient(auth,
+					reddit.WithHTTPClient(mockClient),
+					reddit.WithRetryConfig(config),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				subreddit = reddit.NewSubreddit("golang", client)
+
+				transport.SetErrorOnCall(2, &net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded})
+				transport.AddResponse("/r/golang.json", emptyListing())
+
+				_, err = subreddit.GetPosts(context.Background())
+				Expect(err).To(HaveOccurred())
+				Expect(countGolangCalls()).To(Equal(1))
+			})
+		})
+
+		Context("with a per-attempt timeout", func() {
+			newHangingClient := func(hang int32, opts ...reddit.ClientOption) (*reddit.Client, *hangingTransport) {
+				hanging := &hangingTransport{listingTransport: &listingTransport{}, hang: hang}
+				auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(hanging))
+				Expect(err).NotTo(HaveOccurred())
+
+				opts = append([]reddit.ClientOption{
+					reddit.WithHTTPClient(&http.Client{Transport: hanging}),
+					reddit.WithRequestTimeout(50 * time.Millisecond),
+				}, opts...)
+				client, err := reddit.NewClient(auth, opts...)
+				Expect(err).NotTo(HaveOccurred())
+				return client, hanging
+			}
+
+			It("abandons an attempt that hangs past the timeout and retries", func() {
+				client, hanging := newHangingClient(1, reddit.WithRetries(2), reddit.WithRetryDelay(time.Millisecond))
+
+				start := time.Now()
+				posts, err := reddit.NewSubreddit("golang", client).GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(1))
+				Expect(hanging.requests.Load()).To(Equal(int32(2)))
+				Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+			})
+
+			It("returns the deadline error when every attempt hangs", func() {
+				client, hanging := newHangingClient(3, reddit.WithRetries(1), reddit.WithRetryDelay(time.Millisecond))
+
+				_, err := reddit.NewSubreddit("golang", client).GetPosts(context.Background())
+				Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+				Expect(hanging.requests.Load()).To(Equal(int32(2)))
+			})
+
+			It("keeps the response body readable after the request returns", func() {
+				client, _ := newHangingClient(0)
+
+				posts, err := reddit.NewSubreddit("golang", client).GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(1))
+			})
+
+			It("rejects a negative timeout", func() {
+				_, err := reddit.NewClient(auth, reddit.WithRequestTimeout(-time.Second))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("client.WithRequestTimeout"))
+			})
+		})
+	})
+
+	Describe("DefaultShouldRetryNetworkError", func() {
+		It("retries timeouts and connection resets", func() {
+			Expect(reddit.DefaultShouldRetryNetworkError(&net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded})).To(BeTrue())
+			Expect(reddit.DefaultShouldRetryNetworkError(&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET})).To(BeTrue())
+		})
+
+		It("does not retry cancellation or permanent TLS errors", func() {
+			Expect(reddit.DefaultShouldRetryNetworkError(nil)).To(BeFalse())
+			Expect(reddit.DefaultShouldRetryNetworkError(fmt.Errorf("wrapped: %w", context.Canceled))).To(BeFalse())
+			Expect(reddit.DefaultShouldRetryNetworkError(x509.HostnameError{Host: "example.com"})).To(BeFalse())
+			Expect(reddit.DefaultShouldRetryNetworkError(&tls.CertificateVerificationError{Err: errors.New("bad cert")})).To(BeFalse())
+		})
 	})
 
 	Describe("BuildEndpoint", func() {
@@ -529,6 +845,19 @@ var _ = Describe("Client", func() {
 			Expect(result1).To(Equal(result2))
 			Expect(result2).To(Equal(result3))
 		})
+
+		It("encodes parameters exactly like url.Values", func() {
+			params := map[string]string{
+				"limit": "100", "after": "t3_abc", "sort": "top", "t": "week", "q": "go & rust",
+				"sr_detail": "1", "include_over_18": "on", "raw_json": "1", "depth": "5", "ä": "ö",
+			}
+			values := url.Values{}
+			for key, value := range params {
+				values.Set(key, value)
+			}
+
+			Expect(reddit.BuildEndpoint("/r/golang.json", params)).To(Equal("/r/golang.json?" + values.Encode()))
+		})
 	})
 
 	Describe("JSON Response Handling", func() {
@@ -625,6 +954,94 @@ var _ = Describe("Client", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(comments).To(HaveLen(1))
 			})
+
+			It("reports an invalid comment sort before requesting comments", func() {
+				post := reddit.Post{ID: "post123", Subreddit: "golang"}
+				transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{"data": map[string]any{"id": post.ID, "subreddit": post.Subreddit}},
+						},
+					},
+				}))
+
+				posts, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(1))
+				callsBefore := transport.GetCallCount()
+
+				comments, err := posts[0].GetComments(context.Background(), reddit.WithCommentSort("best"))
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, reddit.ErrInvalidSort)).To(BeTrue())
+				Expect(comments).To(BeNil())
+				Expect(transport.GetCallCount()).To(Equal(callsBefore))
+			})
+
+			It("sends comment options as query parameters", func() {
+				transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{"data": map[string]any{"id": "post123", "subreddit": "golang"}},
+						},
+					},
+				}))
+				transport.AddResponse("/r/golang/comments/post123", reddit.CreateJSONResponse([]any{
+					map[string]any{},
+					map[string]any{"data": map[string]any{"children": []any{}}},
+				}))
+
+				posts, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(1))
+
+				_, err = posts[0].GetComments(context.Background(), reddit.WithCommentSort("top"))
+				Expect(err).NotTo(HaveOccurred())
+
+				history := transport.GetCallHistory()
+				commentsCall := history[len(history)-1]
+				Expect(commentsCall).To(HavePrefix("/r/golang/comments/post123?"))
+				Expect(commentsCall).To(ContainSubstring("sort=top"))
+				Expect(commentsCall).To(ContainSubstring("limit=100")) // Default limit is kept
+			})
+
+			It("sends comment options with every page fetched by GetCommentsAfter", func() {
+				transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{"data": map[string]any{"id": "post123", "subreddit": "golang"}},
+						},
+					},
+				}))
+				transport.AddResponseToQueue("/r/golang/comments/post123", reddit.CreateJSONResponse([]any{
+					map[string]any{},
+					map[string]any{"data": map[string]any{"children": []any{
+						map[string]any{"data": map[string]any{"id": "c1", "body": "first"}},
+						map[string]any{"data": map[string]any{"id": "c2", "body": "second"}},
+					}}},
+				}))
+				transport.AddResponseToQueue("/r/golang/comments/post123", reddit.CreateJSONResponse([]any{
+					map[string]any{},
+					map[string]any{"data": map[string]any{"children": []any{}}},
+				}))
+
+				posts, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(1))
+
+				comments, err := posts[0].GetCommentsAfter(context.Background(), nil, reddit.WithCommentSort("top"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(comments).To(HaveLen(2))
+
+				var commentCalls []string
+				for _, call := range transport.GetCallHistory() {
+					if strings.HasPrefix(call, "/r/golang/comments/post123?") {
+						commentCalls = append(commentCalls, call)
+					}
+				}
+				Expect(commentCalls).To(HaveLen(2))
+				Expect(commentCalls).To(HaveEach(ContainSubstring("sort=top")))
+				Expect(commentCalls[1]).To(ContainSubstring("after=t1_c2"))
+			})
 		})
 
 		Context("when handling malformed JSON responses", func() {
@@ -691,24 +1108,59 @@ var _ = Describe("Client", func() {
 				posts, err := subreddit.GetPosts(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(posts).To(BeNil())
-				Expect(err.Error()).To(ContainSubstring("decoding JSON response failed"))
-			})
-		})
-	})
+				Expect(errors.Is(err, reddit.ErrEmptyResponse)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("GET /r/golang.json"))
+				Expect(err.Error()).NotTo(ContainSubstring("decoding JSON response failed"))
 
-	Describe("Rate Limit Header Processing", func() {
-		var client *reddit.Client
-		var subreddit *reddit.Subreddit
+				var apiErr *reddit.APIError
+				Expect(errors.As(err, &apiErr)).To(BeTrue())
+				Expect(apiErr.StatusCode).To(Equal(http.StatusOK))
+			})
 
-		BeforeEach(func() {
-			var err error
-			client, err = reddit.NewClient(auth,
-				reddit.WithHTTPClient(mockClient),
-			)
-			Expect(err).NotTo(HaveOccurred())
-			subreddit = reddit.NewSubreddit("golang", client)
-			transport.Reset()
-		})
+			It("distinguishes empty gzipped responses from malformed JSON", func() {
+				var buf bytes.Buffer
+				gzWriter := gzip.NewWriter(&buf)
+				gzWriter.Close()
+
+				resp := &http.Response{
+					StatusCode: 200,
+					Body:       io.NopCloser(&buf),
+					Header:     make(http.Header),
+				}
+				resp.Header.Set("Content-Encoding", "gzip")
+				transport.AddResponse("/r/golang.json", resp)
+
+				_, err := subreddit.GetPosts(context.Background())
+				Expect(errors.Is(err, reddit.ErrEmptyResponse)).To(BeTrue())
+			})
+
+			It("does not report malformed JSON as an empty response", func() {
+				transport.AddResponse("/r/golang.json", &http.Response{
+					StatusCode: 200,
+					Body:       io.NopCloser(strings.NewReader(`{"invalid": json`)),
+					Header:     make(http.Header),
+				})
+
+				_, err := subreddit.GetPosts(context.Background())
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, reddit.ErrEmptyResponse)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Rate Limit Header Processing", func() {
+		var client *reddit.Client
+		var subreddit *reddit.Subreddit
+
+		BeforeEach(func() {
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+			transport.Reset()
+		})
 
 		Context("with all rate limit headers present", func() {
 			It("successfully parses and updates rate limiter with all headers", func() {
@@ -1131,6 +1583,58 @@ var _ = Describe("Client", func() {
 			})
 		})
 
+		Context("OnRateLimitReconfigured", func() {
+			respondWithHeaders := func(remaining string, reset time.Time) {
+				resp := reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{},
+						"after":    nil,
+					},
+				})
+				resp.Header = make(http.Header)
+				resp.Header.Set("X-Ratelimit-Remaining", remaining)
+				resp.Header.Set("X-Ratelimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+				transport.AddResponse("/r/golang.json", resp)
+			}
+
+			It("reports the rate and burst recomputed from headers", func() {
+				// 600 requests left for the next 10 minutes is one request per second
+				respondWithHeaders("600", time.Now().Add(10*time.Minute))
+
+				_, err := subreddit.GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(hookCalls.reconfiguredCalls).To(HaveLen(1))
+				Expect(hookCalls.reconfiguredCalls[0].requestsPerMinute).To(BeNumerically("~", 60, 1))
+				Expect(hookCalls.reconfiguredCalls[0].burst).To(Equal(5))
+			})
+
+			It("reports the throttled rate when the budget is exhausted", func() {
+				respondWithHeaders("0", time.Now().Add(time.Second))
+
+				_, err := subreddit.GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(hookCalls.reconfiguredCalls).To(HaveLen(1))
+				Expect(hookCalls.reconfiguredCalls[0].requestsPerMinute).To(BeNumerically("~", 6, 0.01))
+				Expect(hookCalls.reconfiguredCalls[0].burst).To(Equal(1))
+			})
+
+			It("can be implemented alone by embedding NoopRateLimitHook", func() {
+				hook := &reconfiguredOnlyHook{}
+				client, err := reddit.NewClient(auth,
+					reddit.WithHTTPClient(mockClient),
+					reddit.WithRateLimitHook(hook),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				respondWithHeaders("30", time.Now().Add(time.Minute))
+
+				_, err = reddit.NewSubreddit("golang", client).GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(hook.bursts).To(Equal([]int{3}))
+			})
+		})
+
 		Context("OnRateLimitExceeded", func() {
 			It("calls hook when remaining requests is zero", func() {
 				resp := reddit.CreateJSONResponse(map[string]any{
@@ -1232,6 +1736,38 @@ var _ = Describe("Client", func() {
 				// The test passes if we can successfully use a client with hooks
 				// Rate limiting timing tests are covered in other integration tests
 			})
+
+			It("reports the wait until the reset once the budget is exhausted", func() {
+				testHook := &testRateLimitHook{}
+				client, err := reddit.NewClient(auth,
+					reddit.WithHTTPClient(mockClient),
+					reddit.WithRateLimitHook(testHook),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				subreddit := reddit.NewSubreddit("golang", client)
+
+				resp := reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{"children": []any{}, "after": nil},
+				})
+				resp.Header = make(http.Header)
+				resp.Header.Set("X-Ratelimit-Remaining", "0")
+				resp.Header.Set("X-Ratelimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+				transport.AddResponse("/r/golang.json", resp)
+
+				_, err = subreddit.GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(testHook.waitCalls).To(BeEmpty())
+
+				// The next request is held until the reset rather than the limiter's next token
+				ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+				defer cancel()
+				_, err = subreddit.GetPosts(ctx)
+				Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+
+				Expect(testHook.waitCalls).To(HaveLen(1))
+				Expect(testHook.waitCalls[0].duration).To(BeNumerically(">", 59*time.Minute))
+				Expect(testHook.waitCalls[0].duration).To(BeNumerically("<=", time.Hour))
+			})
 		})
 
 		Context("with LoggingRateLimitHook", func() {
@@ -1294,11 +1830,23 @@ var _ = Describe("Client", func() {
 	})
 })
 
+// timingTransport records when each request is sent before passing it on
+type timingTransport struct {
+	next  http.RoundTripper
+	times []time.Time
+}
+
+func (t *timingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.times = append(t.times, time.Now())
+	return t.next.RoundTrip(req)
+}
+
 // testRateLimitHook is a test implementation of RateLimitHook that records all calls
 type testRateLimitHook struct {
-	waitCalls     []waitCall
-	updateCalls   []updateCall
-	exceededCalls []exceededCall
+	waitCalls         []waitCall
+	updateCalls       []updateCall
+	exceededCalls     []exceededCall
+	reconfiguredCalls []reconfiguredCall
 }
 
 type waitCall struct {
@@ -1314,6 +1862,11 @@ type exceededCall struct {
 	// We could store context info here if needed
 }
 
+type reconfiguredCall struct {
+	requestsPerMinute float64
+	burst             int
+}
+
 func (h *testRateLimitHook) OnRateLimitWait(ctx context.Context, duration time.Duration) {
 	h.waitCalls = append(h.waitCalls, waitCall{duration: duration})
 }
@@ -1326,6 +1879,20 @@ func (h *testRateLimitHook) OnRateLimitExceeded(ctx context.Context) {
 	h.exceededCalls = append(h.exceededCalls, exceededCall{})
 }
 
+func (h *testRateLimitHook) OnRateLimitReconfigured(requestsPerMinute float64, burst int) {
+	h.reconfiguredCalls = append(h.reconfiguredCalls, reconfiguredCall{requestsPerMinute: requestsPerMinute, burst: burst})
+}
+
+// reconfiguredOnlyHook implements only OnRateLimitReconfigured, taking the rest from NoopRateLimitHook
+type reconfiguredOnlyHook struct {
+	reddit.NoopRateLimitHook
+	bursts []int
+}
+
+func (h *reconfiguredOnlyHook) OnRateLimitReconfigured(requestsPerMinute float64, burst int) {
+	h.bursts = append(h.bursts, burst)
+}
+
 var _ = Describe("Client Circuit Breaker Integration", func() {
 	var (
 		transport  *reddit.TestTransport
@@ -1468,47 +2035,359 @@ var _ = Describe("Client Circuit Breaker Integration", func() {
 				Body:       io.NopCloser(strings.NewReader(`{"error": "bad request"}`)),
 			})
 
-			// Make many requests with client errors
-			for i := 0; i < 5; i++ {
-				_, err := subreddit.GetPosts(context.Background())
-				Expect(err).To(HaveOccurred())
-				Expect(reddit.IsServerError(err)).To(BeFalse())
-			}
+			// Make many requests with client errors
+			for i := 0; i < 5; i++ {
+				_, err := subreddit.GetPosts(context.Background())
+				Expect(err).To(HaveOccurred())
+				Expect(reddit.IsServerError(err)).To(BeFalse())
+			}
+
+			// Circuit should still be closed since client errors don't trip it
+			_, err := subreddit.GetPosts(context.Background())
+			Expect(err).To(HaveOccurred())
+			// Should be API error, not circuit breaker error
+			var cbErr *reddit.CircuitBreakerError
+			Expect(errors.As(err, &cbErr)).To(BeFalse())
+		})
+
+		It("should allow requests in half-open state", func() {
+			// Trip the circuit
+			transport.AddResponse("/r/golang.json", &http.Response{
+				StatusCode: 500,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"error": "internal server error"}`)),
+			})
+
+			for i := 0; i < 2; i++ {
+				_, err := subreddit.GetPosts(context.Background())
+				Expect(err).To(HaveOccurred())
+			}
+
+			// Wait for timeout
+			time.Sleep(110 * time.Millisecond)
+
+			// Set up successful response
+			transport.AddResponse("/r/golang.json", &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"kind": "Listing", "data": {"children": []}}`)),
+			})
+
+			// Make a request in half-open state - should succeed
+			_, err := subreddit.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("WithMetricsHook", func() {
+		It("should observe each attempt's status and count retries", func() {
+			hook := &recordingMetricsHook{}
+
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithMetricsHook(hook),
+				reddit.WithRetries(1),
+				reddit.WithRetryDelay(time.Millisecond),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+
+			transport.AddResponseToQueue("/r/golang.json", &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"error": "unavailable"}`)),
+			})
+			transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}, "after": nil},
+			}))
+
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(hook.statusCodes).To(Equal([]int{http.StatusServiceUnavailable, http.StatusOK}))
+			Expect(hook.endpoints).To(Equal([]string{"/r/golang.json", "/r/golang.json"}))
+			Expect(hook.retries).To(Equal([]string{"/r/golang.json"}))
+			Expect(hook.waits).To(Equal(1))
+		})
+
+		It("should report network failures with a zero status code", func() {
+			hook := &recordingMetricsHook{}
+
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithMetricsHook(hook),
+				reddit.WithNoRetries(),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+
+			// The first call is the token request
+			transport.SetErrorOnCall(2, errors.New("connection reset"))
+
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).To(HaveOccurred())
+			Expect(hook.statusCodes).To(Equal([]int{0}))
+			Expect(hook.retries).To(BeEmpty())
+		})
+	})
+
+	Describe("RequestCount", func() {
+		It("should count every attempt including retries", func() {
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithRetries(1),
+				reddit.WithRetryDelay(time.Millisecond),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+			Expect(client.RequestCount()).To(BeZero())
+
+			transport.AddResponseToQueue("/r/golang.json", &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"error": "unavailable"}`)),
+			})
+			for i := 0; i < 2; i++ {
+				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{"children": []any{}, "after": nil},
+				}))
+			}
+
+			// One request retried once, then one request that succeeds first time
+			for i := 0; i < 2; i++ {
+				_, err = subreddit.GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// The token request is made by Auth and is not counted
+			Expect(client.RequestCount()).To(Equal(3))
+		})
+	})
+
+	Describe("Close", func() {
+		It("should close idle connections and reject later requests", func() {
+			closing := &closeTrackingTransport{listingTransport: &listingTransport{}}
+			client, err := reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: closing}))
+			Expect(err).NotTo(HaveOccurred())
+			subreddit := reddit.NewSubreddit("golang", client)
+
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.Close()).To(Succeed())
+			Expect(closing.closeCalls.Load()).To(Equal(int32(1)))
+
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(errors.Is(err, reddit.ErrClientClosed)).To(BeTrue())
+			Expect(closing.requests.Load()).To(Equal(int32(1)))
+		})
+
+		It("should be safe to call more than once", func() {
+			closing := &closeTrackingTransport{listingTransport: &listingTransport{}}
+			client, err := reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: closing}))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.Close()).To(Succeed())
+			Expect(client.Close()).To(Succeed())
+			Expect(closing.closeCalls.Load()).To(Equal(int32(1)))
+		})
+	})
+
+	Describe("WithPerEndpointCircuitBreaker", func() {
+		It("should trip the failing endpoint's breaker without blocking other endpoints", func() {
+			config := &reddit.CircuitBreakerConfig{
+				FailureThreshold: 2,
+				SuccessThreshold: 1,
+				Timeout:          time.Minute,
+				ShouldTrip: func(err error) bool {
+					return reddit.IsServerError(err)
+				},
+			}
+
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithPerEndpointCircuitBreaker(config),
+				reddit.WithNoRetries(),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			failing := reddit.NewSubreddit("failing", client)
+			healthy := reddit.NewSubreddit("golang", client)
+
+			for i := 0; i < 2; i++ {
+				transport.AddResponseToQueue("/r/failing.json", &http.Response{
+					StatusCode: 500,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       io.NopCloser(strings.NewReader(`{"error": "internal server error"}`)),
+				})
+			}
+			for i := 0; i < 2; i++ {
+				_, err := failing.GetPosts(context.Background())
+				Expect(reddit.IsServerError(err)).To(BeTrue())
+			}
+
+			// The failing endpoint now fails fast
+			_, err = failing.GetPosts(context.Background())
+			var cbErr *reddit.CircuitBreakerError
+			Expect(errors.As(err, &cbErr)).To(BeTrue())
+			Expect(cbErr.State).To(Equal(reddit.CircuitOpen))
+
+			// Other endpoints are unaffected
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}, "after": nil},
+			}))
+			_, err = healthy.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should count failures on different posts towards the same endpoint", func() {
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithPerEndpointCircuitBreaker(&reddit.CircuitBreakerConfig{
+					FailureThreshold: 2,
+					Timeout:          time.Minute,
+					ShouldTrip:       reddit.IsServerError,
+				}),
+				reddit.WithNoRetries(),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{
+					map[string]any{"data": map[string]any{"id": "post1", "subreddit": "golang"}},
+					map[string]any{"data": map[string]any{"id": "post2", "subreddit": "golang"}},
+				}},
+			}))
+			posts, err := reddit.NewSubreddit("golang", client).GetPosts(context.Background(), reddit.WithSubredditLimit(2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(2))
+
+			for _, post := range posts {
+				transport.AddResponse("/r/golang/comments/"+post.ID, &http.Response{
+					StatusCode: 500,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       io.NopCloser(strings.NewReader(`{"error": "internal server error"}`)),
+				})
+				_, err := post.GetComments(context.Background())
+				Expect(reddit.IsServerError(err)).To(BeTrue())
+			}
+
+			// Both failures count towards the comments endpoint, which now fails fast for any post
+			_, err = posts[0].GetComments(context.Background())
+			var cbErr *reddit.CircuitBreakerError
+			Expect(errors.As(err, &cbErr)).To(BeTrue())
+		})
+	})
+
+	Describe("WithCircuitOpenInterceptor", func() {
+		It("observes requests the open breaker fails fast", func() {
+			var fastFails []string
+			var breakerErrs []*reddit.CircuitBreakerError
+			responses := 0
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithCircuitBreaker(&reddit.CircuitBreakerConfig{Timeout: time.Minute}),
+				reddit.WithResponseInterceptor(func(resp *http.Response) error {
+					responses++
+					return nil
+				}),
+				reddit.WithCircuitOpenInterceptor(func(ctx context.Context, req *http.Request, err *reddit.CircuitBreakerError) {
+					fastFails = append(fastFails, req.Method+" "+req.URL.Path)
+					breakerErrs = append(breakerErrs, err)
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}, "after": nil},
+			}))
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fastFails).To(BeEmpty())
+			Expect(responses).To(Equal(1))
+
+			client.TripCircuitBreaker()
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(reddit.IsCircuitBreakerOpen(err)).To(BeTrue())
+
+			Expect(fastFails).To(Equal([]string{"GET /r/golang.json"}))
+			Expect(breakerErrs[0].Reason).To(Equal(reddit.CircuitBreakerReasonOpen))
+			Expect(responses).To(Equal(1)) // Response interceptors don't run for the fast-failed request
+		})
+	})
+
+	Describe("ResetCircuitBreaker and TripCircuitBreaker", func() {
+		emptyListing := func() *http.Response {
+			return reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}, "after": nil},
+			})
+		}
+
+		It("should force the client's breaker open and closed", func() {
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithCircuitBreaker(&reddit.CircuitBreakerConfig{Timeout: time.Minute}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+
+			client.TripCircuitBreaker()
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(reddit.IsCircuitBreakerOpen(err)).To(BeTrue())
 
-			// Circuit should still be closed since client errors don't trip it
-			_, err := subreddit.GetPosts(context.Background())
-			Expect(err).To(HaveOccurred())
-			// Should be API error, not circuit breaker error
-			var cbErr *reddit.CircuitBreakerError
-			Expect(errors.As(err, &cbErr)).To(BeFalse())
+			client.ResetCircuitBreaker()
+			transport.AddResponse("/r/golang.json", emptyListing())
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
 		})
 
-		It("should allow requests in half-open state", func() {
-			// Trip the circuit
-			transport.AddResponse("/r/golang.json", &http.Response{
-				StatusCode: 500,
-				Header:     http.Header{"Content-Type": []string{"application/json"}},
-				Body:       io.NopCloser(strings.NewReader(`{"error": "internal server error"}`)),
-			})
+		It("should apply to every per-endpoint breaker", func() {
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithPerEndpointCircuitBreaker(&reddit.CircuitBreakerConfig{Timeout: time.Minute}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			golang := reddit.NewSubreddit("golang", client)
+			rust := reddit.NewSubreddit("rust", client)
 
-			for i := 0; i < 2; i++ {
-				_, err := subreddit.GetPosts(context.Background())
-				Expect(err).To(HaveOccurred())
-			}
+			transport.AddResponse("/r/golang.json", emptyListing())
+			transport.AddResponse("/r/rust.json", emptyListing())
+			_, err = golang.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			_, err = rust.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
 
-			// Wait for timeout
-			time.Sleep(110 * time.Millisecond)
+			client.TripCircuitBreaker()
+			_, err = golang.GetPosts(context.Background())
+			Expect(reddit.IsCircuitBreakerOpen(err)).To(BeTrue())
+			_, err = rust.GetPosts(context.Background())
+			Expect(reddit.IsCircuitBreakerOpen(err)).To(BeTrue())
 
-			// Set up successful response
-			transport.AddResponse("/r/golang.json", &http.Response{
-				StatusCode: 200,
-				Header:     http.Header{"Content-Type": []string{"application/json"}},
-				Body:       io.NopCloser(strings.NewReader(`{"kind": "Listing", "data": {"children": []}}`)),
-			})
+			client.ResetCircuitBreaker()
+			transport.AddResponse("/r/golang.json", emptyListing())
+			_, err = golang.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+		})
 
-			// Make a request in half-open state - should succeed
-			_, err := subreddit.GetPosts(context.Background())
+		It("should do nothing without a circuit breaker", func() {
+			var err error
+			client, err = reddit.NewClient(auth, reddit.WithHTTPClient(mockClient))
+			Expect(err).NotTo(HaveOccurred())
+
+			client.TripCircuitBreaker()
+			transport.AddResponse("/r/golang.json", emptyListing())
+			_, err = reddit.NewSubreddit("golang", client).GetPosts(context.Background())
 			Expect(err).NotTo(HaveOccurred())
+			client.ResetCircuitBreaker()
 		})
 	})
 
@@ -1926,6 +2805,71 @@ var _ = Describe("Client Compression Support", func() {
 			Expect(err.Error()).To(ContainSubstring("decoding JSON response failed"))
 		})
 	})
+
+	Context("with a maximum response size", func() {
+		const listing = `{"data":{"children":[],"after":null}}`
+
+		newLimitedSubreddit := func(limit int64) *reddit.Subreddit {
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithMaxResponseBytes(limit),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			return reddit.NewSubreddit("golang", client)
+		}
+
+		listingResponse := func(body []byte) *http.Response {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewReader(body)),
+				Header:     make(http.Header),
+			}
+		}
+
+		It("accepts bodies up to the limit", func() {
+			transport.AddResponse("/r/golang.json", listingResponse([]byte(listing)))
+
+			_, err := newLimitedSubreddit(int64(len(listing))).GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns ErrResponseTooLarge for bodies over the limit", func() {
+			transport.AddResponse("/r/golang.json", listingResponse([]byte(listing)))
+
+			posts, err := newLimitedSubreddit(int64(len(listing) - 1)).GetPosts(context.Background())
+			Expect(errors.Is(err, reddit.ErrResponseTooLarge)).To(BeTrue())
+			Expect(posts).To(BeNil())
+
+			var apiErr *reddit.APIError
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("limits compressed responses by their decompressed size", func() {
+			gzipped := reddit.CreateGzippedJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "post1", "selftext": strings.Repeat("a", 100000)}},
+					},
+					"after": nil,
+				},
+			})
+			compressed, err := io.ReadAll(gzipped.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(compressed)).To(BeNumerically("<", 1000))
+			gzipped.Body = io.NopCloser(bytes.NewReader(compressed))
+			transport.AddResponse("/r/golang.json", gzipped)
+
+			_, err = newLimitedSubreddit(1000).GetPosts(context.Background())
+			Expect(errors.Is(err, reddit.ErrResponseTooLarge)).To(BeTrue())
+		})
+
+		It("rejects a limit that is not positive", func() {
+			_, err := reddit.NewClient(auth, reddit.WithMaxResponseBytes(0))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("client.WithMaxResponseBytes"))
+		})
+	})
 })
 
 var _ = Describe("Client Request and Response Interceptors", func() {
@@ -2051,6 +2995,56 @@ var _ = Describe("Client Request and Response Interceptors", func() {
 			// Verify first interceptor was called, but third was not
 			Expect(callOrder).To(Equal([]string{"first", "error"}))
 		})
+
+		It("passes the caller's context to context-aware interceptors", func() {
+			type traceKey struct{}
+			ctx := context.WithValue(context.Background(), traceKey{}, "trace-123")
+
+			var callOrder []string
+			var traceID any
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithRequestInterceptor(func(req *http.Request) error {
+					callOrder = append(callOrder, "plain")
+					return nil
+				}),
+				reddit.WithContextRequestInterceptor(func(ctx context.Context, req *http.Request) error {
+					callOrder = append(callOrder, "context")
+					traceID = ctx.Value(traceKey{})
+					return nil
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{},
+					"after":    nil,
+				},
+			}))
+
+			_, err = subreddit.GetPosts(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(traceID).To(Equal("trace-123"))
+			Expect(callOrder).To(Equal([]string{"plain", "context"}))
+		})
+
+		It("cancels request when a context-aware interceptor returns error", func() {
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithContextRequestInterceptor(func(ctx context.Context, req *http.Request) error {
+					return errors.New("missing trace id")
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).To(MatchError(ContainSubstring("request interceptor 0 failed: missing trace id")))
+		})
 	})
 
 	Context("Response Interceptors", func() {
@@ -2160,6 +3154,82 @@ var _ = Describe("Client Request and Response Interceptors", func() {
 		})
 	})
 
+	Context("Body Response Interceptors", func() {
+		It("passes a copy of the body and still decodes the response", func() {
+			var captured []byte
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithBodyResponseInterceptor(func(resp *http.Response, body []byte) error {
+					captured = body
+					// Modifying the copy must not affect decoding
+					for i := range body {
+						body[i] = 'x'
+					}
+					return nil
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+
+			transport.AddResponse("/r/golang.json", reddit.CreateGzippedJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "abc", "title": "Hello"}},
+					},
+					"after": nil,
+				},
+			}))
+
+			posts, err := subreddit.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			Expect(posts[0].Title).To(Equal("Hello"))
+			Expect(captured).NotTo(BeEmpty())
+		})
+
+		It("receives the decompressed body", func() {
+			var captured string
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithBodyResponseInterceptor(func(resp *http.Response, body []byte) error {
+					captured = string(body)
+					return nil
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+
+			transport.AddResponse("/r/golang.json", reddit.CreateGzippedJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}, "after": nil},
+			}))
+
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(captured).To(ContainSubstring(`"children":[]`))
+		})
+
+		It("fails the request when a body interceptor returns an error", func() {
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithBodyResponseInterceptor(func(resp *http.Response, body []byte) error {
+					return errors.New("schema mismatch")
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}, "after": nil},
+			}))
+
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).To(MatchError(ContainSubstring("body response interceptor 0 failed: schema mismatch")))
+		})
+	})
+
 	Context("Combined Request and Response Interceptors", func() {
 		It("calls request and response interceptors together", func() {
 			var callOrder []string
@@ -2397,6 +3467,43 @@ var _ = Describe("Client Request and Response Interceptors", func() {
 
 			// Test passes if no errors occur
 		})
+
+		It("fails the request when SchemaValidationResponseInterceptor rejects the body", func() {
+			errMissingAfter := errors.New("listing has no after field")
+			var err error
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithBodyResponseInterceptor(reddit.SchemaValidationResponseInterceptor(func(body []byte) error {
+					var listing struct {
+						Data map[string]json.RawMessage `json:"data"`
+					}
+					if err := json.Unmarshal(body, &listing); err != nil {
+						return err
+					}
+					if _, ok := listing.Data["after"]; !ok {
+						return errMissingAfter
+					}
+					return nil
+				})),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}, "after": nil},
+			}))
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}},
+			}))
+			_, err = subreddit.GetPosts(context.Background())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("response interceptor 0 failed"))
+			Expect(err.Error()).To(ContainSubstring("failed schema validation"))
+			Expect(errors.Is(err, errMissingAfter)).To(BeTrue())
+		})
 	})
 
 	Context("No Interceptors", func() {
@@ -2422,3 +3529,295 @@ var _ = Describe("Client Request and Response Interceptors", func() {
 		})
 	})
 })
+
+var _ = Describe("Client Error Contract", func() {
+	var (
+		transport *reddit.TestTransport
+		subreddit *reddit.Subreddit
+	)
+
+	BeforeEach(func() {
+		transport = reddit.NewTestTransport()
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err := reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: transport}))
+		Expect(err).NotTo(HaveOccurred())
+		subreddit = reddit.NewSubreddit("golang", client)
+	})
+
+	expectAPIError := func(expectedStatus int) {
+		_, err := subreddit.GetPosts(context.Background())
+		Expect(err).To(HaveOccurred())
+
+		var apiErr *reddit.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(expectedStatus))
+	}
+
+	It("returns an *APIError for a 404", func() {
+		transport.AddResponse("/r/golang.json", &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody})
+		expectAPIError(http.StatusNotFound)
+	})
+
+	It("returns an *APIError for a 429", func() {
+		transport.AddResponse("/r/golang.json", &http.Response{StatusCode: http.StatusTooManyRequests, Body: http.NoBody})
+		expectAPIError(http.StatusTooManyRequests)
+	})
+
+	It("returns an *APIError for a 500", func() {
+		transport.AddResponse("/r/golang.json", &http.Response{StatusCode: http.StatusInternalServerError, Body: http.NoBody})
+		expectAPIError(http.StatusInternalServerError)
+	})
+
+	It("returns an *APIError for a response that cannot be decoded", func() {
+		transport.AddResponse("/r/golang.json", &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("{not json")),
+		})
+		expectAPIError(http.StatusOK)
+	})
+
+	It("returns an *APIError with status 0 for a network failure", func() {
+		// Call 1 is authentication, call 2 is the listing request
+		transport.SetErrorOnCall(2, &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED})
+		expectAPIError(0)
+	})
+
+	It("keeps the underlying network error reachable", func() {
+		transport.SetErrorOnCall(2, &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED})
+
+		_, err := subreddit.GetPosts(context.Background())
+		Expect(errors.Is(err, syscall.ECONNREFUSED)).To(BeTrue())
+	})
+
+	It("returns an *APIError when authentication fails", func() {
+		transport.SetErrorOnCall(1, &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED})
+
+		_, err := subreddit.GetPosts(context.Background())
+		var apiErr *reddit.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(0))
+	})
+})
+
+var _ = Describe("Client Concurrency", func() {
+	It("handles concurrent requests that update the rate limit from headers", func() {
+		transport := &listingTransport{rateLimitHeaders: true}
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		client, err := reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithRateLimit(6000, 100),
+			reddit.WithCircuitBreaker(reddit.DefaultCircuitBreakerConfig()),
+			reddit.WithRateLimitHook(&reddit.LoggingRateLimitHook{}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		subreddit := reddit.NewSubreddit("golang", client)
+
+		const workers = 20
+		var wg sync.WaitGroup
+		errs := make(chan error, workers)
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, err := subreddit.GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(transport.tokenRequests.Load()).To(Equal(int32(1)))
+	})
+})
+
+// listingTransport is safe for concurrent use. It answers token requests and returns a
+// single-post listing for every other request, optionally with rate limit headers.
+type listingTransport struct {
+	rateLimitHeaders bool
+	tokenRequests    atomic.Int32
+	requests         atomic.Int32
+}
+
+func (t *listingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path == "/api/v1/access_token" {
+		t.tokenRequests.Add(1)
+		return reddit.CreateJSONResponse(map[string]any{
+			"access_token": "test_token",
+			"token_type":   "bearer",
+			"expires_in":   3600,
+		}), nil
+	}
+
+	n := t.requests.Add(1)
+	resp := reddit.CreateJSONResponse(map[string]any{
+		"data": map[string]any{
+			"children": []any{
+				map[string]any{"data": map[string]any{"id": strconv.Itoa(int(n)), "title": "Post"}},
+			},
+			"after": nil,
+		},
+	})
+	resp.Header = make(http.Header)
+	if t.rateLimitHeaders {
+		resp.Header.Set("X-Ratelimit-Remaining", strconv.Itoa(60000-int(n)))
+		resp.Header.Set("X-Ratelimit-Used", strconv.Itoa(int(n)))
+		resp.Header.Set("X-Ratelimit-Reset", strconv.FormatInt(time.Now().Add(10*time.Minute).Unix(), 10))
+	}
+	return resp, nil
+}
+
+// closeTrackingTransport is a listingTransport that counts calls to CloseIdleConnections
+type closeTrackingTransport struct {
+	*listingTransport
+	closeCalls atomic.Int32
+}
+
+func (t *closeTrackingTransport) CloseIdleConnections() {
+	t.closeCalls.Add(1)
+}
+
+// hangingTransport is a listingTransport whose first hang listing requests block until the
+// request's context is done
+type hangingTransport struct {
+	*listingTransport
+	hang  int32
+	calls atomic.Int32
+}
+
+func (t *hangingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path != "/api/v1/access_token" && t.calls.Add(1) <= t.hang {
+		t.requests.Add(1)
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}
+	return t.listingTransport.RoundTrip(req)
+}
+
+// pagedListingTransport serves a fixed crawl of pre-encoded listing pages, choosing the page by
+// the request's after parameter, so benchmarks measure the client rather than the fixture
+type pagedListingTransport struct {
+	pages map[string][]byte // page body keyed by the after token that requests it
+}
+
+func newPagedListingTransport(pageCount, postsPerPage int) *pagedListingTransport {
+	t := &pagedListingTransport{pages: make(map[string][]byte, pageCount)}
+	after := ""
+	for page := 0; page < pageCount; page++ {
+		children := make([]any, 0, postsPerPage)
+		var last string
+		for i := 0; i < postsPerPage; i++ {
+			last = fmt.Sprintf("p%d_%d", page, i)
+			children = append(children, map[string]any{"data": map[string]any{"id": last, "title": "Post"}})
+		}
+		next := ""
+		if page < pageCount-1 {
+			next = "t3_" + last
+		}
+		body, _ := io.ReadAll(reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{"children": children, "after": next},
+		}).Body)
+		t.pages[after] = body
+		after = next
+	}
+	return t
+}
+
+func (t *pagedListingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path == "/api/v1/access_token" {
+		return reddit.CreateJSONResponse(map[string]any{
+			"access_token": "test_token",
+			"token_type":   "bearer",
+			"expires_in":   3600,
+		}), nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader(t.pages[req.URL.Query().Get("after")])),
+	}, nil
+}
+
+func BenchmarkBuildEndpoint(b *testing.B) {
+	b.Run("no params", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			reddit.BuildEndpoint("/r/golang.json", nil)
+		}
+	})
+
+	b.Run("limit only", func(b *testing.B) {
+		params := map[string]string{"limit": "100"}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			reddit.BuildEndpoint("/r/golang.json", params)
+		}
+	})
+
+	b.Run("paginated listing", func(b *testing.B) {
+		params := map[string]string{"limit": "100", "after": "t3_abc123", "sort": "top", "t": "week"}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			reddit.BuildEndpoint("/r/golang.json", params)
+		}
+	})
+}
+
+func BenchmarkGetPostsAfterCrawl(b *testing.B) {
+	const pages, postsPerPage = 10, 5
+	transport := newPagedListingTransport(pages, postsPerPage)
+	auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+	if err != nil {
+		b.Fatal(err)
+	}
+	client, err := reddit.NewClient(auth,
+		reddit.WithHTTPClient(&http.Client{Transport: transport}),
+		reddit.WithRateLimit(1_000_000_000, 1_000_000),
+		reddit.WithNoRetries(),
+		reddit.WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))),
+	)
+	if err != nil {
+		b.Fatal(err)
+	}
+	subreddit := reddit.NewSubreddit("golang", client)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		posts, err := subreddit.GetPostsAfter(ctx, nil, 0)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(posts) != pages*postsPerPage {
+			b.Fatalf("got %d posts, want %d", len(posts), pages*postsPerPage)
+		}
+	}
+}
+
+// recordingMetricsHook records the metrics it observes; it is not safe for concurrent use
+type recordingMetricsHook struct {
+	endpoints   []string
+	statusCodes []int
+	retries     []string
+	waits       int
+}
+
+func (h *recordingMetricsHook) ObserveRequest(endpoint string, statusCode int, duration time.Duration) {
+	h.endpoints = append(h.endpoints, endpoint)
+	h.statusCodes = append(h.statusCodes, statusCode)
+}
+
+func (h *recordingMetricsHook) ObserveRetry(endpoint string) {
+	h.retries = append(h.retries, endpoint)
+}
+
+func (h *recordingMetricsHook) ObserveRateLimitWait(duration time.Duration) {
+	h.waits++
+}
@@ -1,17 +1,30 @@
 package reddit
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 )
 
 // Comment represents a single comment on a Reddit post
 type Comment struct {
-	Author     string `json:"author"`
-	Body       string `json:"body"`
-	Created    int64  `json:"created_utc"`
-	ID         string `json:"id"`
-	IngestedAt int64  `json:"-"` // When we stored it, not from Reddit API
+	Author          string          `json:"author"`
+	Body            string          `json:"body"`
+	Created         int64           `json:"created_utc"`
+	CreatedUTC      time.Time       `json:"-"` // Created as a time, zero if Reddit sent no timestamp
+	Score           int             `json:"score"`
+	ID              string          `json:"id"`
+	Removed         bool            `json:"removed,omitempty"`          // Removed by a moderator
+	CollapsedReason string          `json:"collapsed_reason,omitempty"` // Why Reddit collapsed the comment, if it did
+	Edited          int64           `json:"edited,omitempty"`           // Unix time of the last edit, 0 if never edited
+	ParentID        string          `json:"parent_id,omitempty"`        // Fullname of the parent: t3_ for top-level comments, t1_ for replies
+	LinkID          string          `json:"link_id,omitempty"`          // Fullname of the post the comment belongs to
+	Subreddit       string          `json:"subreddit,omitempty"`        // Name of the subreddit, without the r/ prefix
+	Replies         []Comment       `json:"replies,omitempty"`          // Child comments, populated by BuildCommentTree and GetCommentThread
+	HasMoreReplies  bool            `json:"-"`                          // Reddit left some replies out of Replies; GetReplies loads them
+	IngestedAt      int64           `json:"-"`                          // When we stored it, not from Reddit API
+	client          actionRequester // client for write actions, set when fetched through a Post
 }
 
 // Fullname returns the Reddit fullname identifier for this comment (t1_<id>)
@@ -19,8 +32,24 @@ func (c Comment) Fullname() string {
 	return "t1_" + c.ID
 }
 
-// parseComments extracts comments from the API response
-func parseComments(data []any) ([]Comment, error) {
+// WasEdited reports whether the comment has been edited since it was posted
+func (c Comment) WasEdited() bool {
+	return c.Edited > 0
+}
+
+// EditedAt returns the time of the comment's last edit and whether it was edited at all
+func (c Comment) EditedAt() (time.Time, bool) {
+	if !c.WasEdited() {
+		return time.Time{}, false
+	}
+	return time.Unix(c.Edited, 0).UTC(), true
+}
+
+// parseComments extracts comments from the API response.
+// The client, if it can perform write actions, is attached to each comment.
+func parseComments(data []any, client commentGetter) ([]Comment, error) {
+	requester, _ := client.(actionRequester)
+
 	if len(data) < 2 {
 		return nil, fmt.Errorf("comment.parseComments: unexpected response format")
 	}
@@ -59,12 +88,246 @@ func parseComments(data []any) ([]Comment, error) {
 			continue // Skip comments with missing essential data
 		}
 
+		comment.client = requester
 		comments = append(comments, comment)
 	}
 
 	return comments, nil
 }
 
+// parseCommentThread extracts a comment tree from the API response, following each comment's
+// nested replies into Replies. Placeholders for replies that were not loaded ("more") are skipped,
+// and mark their parent with HasMoreReplies. The requester is attached to each comment.
+func parseCommentThread(data []any, requester actionRequester) ([]Comment, error) {
+	if len(data) < 2 {
+		return nil, fmt.Errorf("comment.parseCommentThread: unexpected response format")
+	}
+
+	listing, ok := data[1].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("comment.parseCommentThread: unexpected response format")
+	}
+	dataMap, ok := listing["data"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("comment.parseCommentThread: invalid data structure")
+	}
+	if _, ok := dataMap["children"].([]any); !ok {
+		return nil, fmt.Errorf("comment.parseCommentThread: missing children array")
+	}
+
+	return parseCommentListing(listing, requester, nowUnix()), nil
+}
+
+// parseCommentListing extracts the comments of a listing and, recursively, their replies
+func parseCommentListing(listing map[string]any, requester actionRequester, now int64) []Comment {
+	dataMap, _ := listing["data"].(map[string]any)
+	children, _ := dataMap["children"].([]any)
+
+	var comments []Comment
+	for _, item := range children {
+		itemMap, ok := item.(map[string]any)
+		if !ok {
+			continue // Skip invalid items
+		}
+		if kind, _ := itemMap["kind"].(string); kind == "more" {
+			continue
+		}
+
+		commentBody, ok := itemMap["data"].(map[string]any)
+		if !ok {
+			continue // Skip invalid comment data
+		}
+
+		comment, err := parseCommentData(commentBody, now)
+		if err != nil {
+			continue // Skip comments with missing essential data
+		}
+		comment.client = requester
+
+		// Reddit sends an empty string instead of a listing for comments without replies
+		if replies, ok := commentBody["replies"].(map[string]any); ok {
+			comment.Replies = parseCommentListing(replies, requester, now)
+			comment.HasMoreReplies = hasMoreChildren(replies)
+		}
+		comments = append(comments, comment)
+	}
+
+	return comments
+}
+
+// hasMoreChildren reports whether a listing has a "more" placeholder for children Reddit did not
+// include, either a list of IDs or a "continue this thread" link
+func hasMoreChildren(listing map[string]any) bool {
+	dataMap, _ := listing["data"].(map[string]any)
+	children, _ := dataMap["children"].([]any)
+	for _, item := range children {
+		itemMap, _ := item.(map[string]any)
+		if kind, _ := itemMap["kind"].(string); kind == "more" {
+			return true
+		}
+	}
+	return false
+}
+
+// moreChildrenIDs returns the IDs of the comments not loaded in the top level of a comments API
+// response, as listed by its "more" placeholders
+func moreChildrenIDs(data []any) []string {
+	if len(data) < 2 {
+		return nil
+	}
+	listing, _ := data[1].(map[string]any)
+	dataMap, _ := listing["data"].(map[string]any)
+	children, _ := dataMap["children"].([]any)
+
+	var ids []string
+	for _, item := range children {
+		itemMap, _ := item.(map[string]any)
+		if kind, _ := itemMap["kind"].(string); kind != "more" {
+			continue
+		}
+		more, _ := itemMap["data"].(map[string]any)
+		childIDs, _ := more["children"].([]any)
+		for _, id := range childIDs {
+			if id, ok := id.(string); ok && id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// trimCommentDepth drops replies nested deeper than maxDepth levels, counting top-level comments
+// as depth 1
+func trimCommentDepth(comments []Comment, maxDepth int) {
+	for i := range comments {
+		if maxDepth <= 1 {
+			comments[i].Replies = nil
+			continue
+		}
+		trimCommentDepth(comments[i].Replies, maxDepth-1)
+	}
+}
+
+// BuildCommentTree reconstructs comment threads from a flat list of comments, linking each comment
+// to its parent through ParentID. It returns the top-level comments with Replies populated, in the
+// order they appear in the input; replies keep their input order too. Existing Replies are replaced.
+//
+// Comments whose parent is not in the list, such as replies fetched across a pagination boundary,
+// are returned at the top level alongside the real top-level comments.
+func BuildCommentTree(comments []Comment) []Comment {
+	present := make(map[string]bool, len(comments))
+	for _, comment := range comments {
+		present[comment.Fullname()] = true
+	}
+
+	// Group the indices of replies by their parent; everything else is a root
+	children := make(map[string][]int)
+	var roots []int
+	for i, comment := range comments {
+		if comment.ParentID != comment.Fullname() && present[comment.ParentID] {
+			children[comment.ParentID] = append(children[comment.ParentID], i)
+		} else {
+			roots = append(roots, i)
+		}
+	}
+
+	visited := make([]bool, len(comments))
+	var build func(i int) Comment
+	build = func(i int) Comment {
+		visited[i] = true
+		comment := comments[i]
+		comment.Replies = nil
+		for _, child := range children[comment.Fullname()] {
+			if !visited[child] {
+				comment.Replies = append(comment.Replies, build(child))
+			}
+		}
+		return comment
+	}
+
+	tree := make([]Comment, 0, len(roots))
+	for _, i := range roots {
+		tree = append(tree, build(i))
+	}
+
+	// Comments caught in a parent cycle are unreachable from the roots; keep them at the top level
+	for i := range comments {
+		if !visited[i] {
+			tree = append(tree, build(i))
+		}
+	}
+
+	return tree
+}
+
+// GetReplies returns the comment's replies, loading them from the thread focused on the comment
+// (/r/{subreddit}/comments/{postID}/_/{commentID}) when Replies is empty or HasMoreReplies reports
+// that Reddit left some out, as happens deep in long threads. Loaded replies, nested down to the
+// depth Reddit returns, replace Replies and clear HasMoreReplies, so expanding a thread on demand
+// costs one request per comment. Options such as WithCommentSort and WithCommentDepth apply as for
+// Post.GetCommentThread. The comment must have been fetched through a client.
+func (c *Comment) GetReplies(ctx context.Context, opts ...CommentOption) ([]Comment, error) {
+	if len(c.Replies) > 0 && !c.HasMoreReplies {
+		return c.Replies, nil
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("comment.GetReplies: comment has no associated client")
+	}
+	postID, ok := strings.CutPrefix(c.LinkID, "t3_")
+	if !ok || postID == "" {
+		return nil, fmt.Errorf("comment.GetReplies: comment has no post ID")
+	}
+
+	thread, err := getCommentThread(ctx, c.client, c.Subreddit, postID, c.ID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("comment.GetReplies: %w", err)
+	}
+
+	for _, comment := range thread {
+		if comment.ID == c.ID {
+			c.Replies = comment.Replies
+			c.HasMoreReplies = comment.HasMoreReplies
+			return c.Replies, nil
+		}
+	}
+	return nil, fmt.Errorf("comment.GetReplies: comment %s: %w", c.ID, ErrNotFound)
+}
+
+// Vote casts the authenticated user's vote on the comment: 1 to upvote, -1 to downvote and 0 to clear
+// an existing vote. It requires user context authentication (see NewAuthWithRefreshToken) with the
+// vote scope, and returns an error wrapping ErrInvalidVote for any other direction.
+func (c *Comment) Vote(ctx context.Context, dir int) error {
+	if c.client == nil {
+		return fmt.Errorf("comment.Vote: comment has no associated client")
+	}
+	if err := vote(ctx, c.client, c.Fullname(), dir); err != nil {
+		return fmt.Errorf("comment.Vote: %w", err)
+	}
+	return nil
+}
+
+// Save saves the comment to the authenticated user's saved items. It requires user context
+// authentication with the save scope; tokens without it get an error wrapping ErrForbidden.
+func (c *Comment) Save(ctx context.Context) error {
+	return c.action(ctx, "comment.Save", "/api/save")
+}
+
+// Unsave removes the comment from the authenticated user's saved items
+func (c *Comment) Unsave(ctx context.Context) error {
+	return c.action(ctx, "comment.Unsave", "/api/unsave")
+}
+
+// action performs a write action that takes only the comment's fullname
+func (c *Comment) action(ctx context.Context, name, endpoint string) error {
+	if c.client == nil {
+		return fmt.Errorf("%s: comment has no associated client", name)
+	}
+	if err := thingAction(ctx, c.client, endpoint, c.Fullname()); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
 // Helper function to get current time in Unix seconds
 func nowUnix() int64 {
 	return time.Now().UTC().Unix()
@@ -77,13 +340,25 @@ func (c Comment) String() string {
 			"    Author: %q\n"+
 			"    Body: %q\n"+
 			"    Created: %d\n"+
+			"    Score: %d\n"+
 			"    ID: %q\n"+
+			"    Removed: %t\n"+
+			"    CollapsedReason: %q\n"+
+			"    Edited: %d\n"+
+			"    ParentID: %q\n"+
+			"    Replies: %d\n"+
 			"    IngestedAt: %d\n"+
 			"}",
 		c.Author,
 		c.Body,
 		c.Created,
+		c.Score,
 		c.ID,
+		c.Removed,
+		c.CollapsedReason,
+		c.Edited,
+		c.ParentID,
+		len(c.Replies),
 		c.IngestedAt,
 	)
 }
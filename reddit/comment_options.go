@@ -8,6 +8,31 @@ import (
 // CommentOption is a function type for modifying comment request parameters
 type CommentOption func(params map[string]string)
 
+// CommentSort represents the sort order of a comment tree
+type CommentSort string
+
+const (
+	CommentSortConfidence    CommentSort = "confidence"
+	CommentSortTop           CommentSort = "top"
+	CommentSortNew           CommentSort = "new"
+	CommentSortControversial CommentSort = "controversial"
+	CommentSortOld           CommentSort = "old"
+	CommentSortRandom        CommentSort = "random"
+	CommentSortQA            CommentSort = "qa"
+	CommentSortLive          CommentSort = "live"
+)
+
+// Valid returns an error if the comment sort order is not one supported by Reddit
+func (s CommentSort) Valid() error {
+	switch s {
+	case CommentSortConfidence, CommentSortTop, CommentSortNew, CommentSortControversial,
+		CommentSortOld, CommentSortRandom, CommentSortQA, CommentSortLive:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidSort, string(s))
+	}
+}
+
 // WithCommentLimit returns a CommentOption that sets the limit parameter
 func WithCommentLimit(limit int) CommentOption {
 	return func(params map[string]string) {
@@ -26,7 +51,8 @@ func WithCommentAfter(comment *Comment) CommentOption {
 	}
 }
 
-// WithCommentSort returns a CommentOption that sets the sort parameter
+// WithCommentSort returns a CommentOption that sets the sort parameter.
+// The value is validated against the CommentSort constants when the request is made.
 func WithCommentSort(sort string) CommentOption {
 	return func(params map[string]string) {
 		if sort != "" {
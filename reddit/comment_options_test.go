@@ -1,6 +1,7 @@
 package reddit_test
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/JohnPlummer/reddit-client/reddit"
@@ -67,6 +68,24 @@ var _ = Describe("Comment Options", func() {
 		})
 	})
 
+	Describe("CommentSort", func() {
+		It("accepts every supported sort order", func() {
+			sortOptions := []reddit.CommentSort{
+				reddit.CommentSortConfidence, reddit.CommentSortTop, reddit.CommentSortNew, reddit.CommentSortControversial,
+				reddit.CommentSortOld, reddit.CommentSortRandom, reddit.CommentSortQA, reddit.CommentSortLive,
+			}
+			for _, sort := range sortOptions {
+				Expect(sort.Valid()).To(Succeed())
+			}
+		})
+
+		It("rejects unknown sort orders", func() {
+			err := reddit.CommentSort("best").Valid()
+			Expect(errors.Is(err, reddit.ErrInvalidSort)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`"best"`))
+		})
+	})
+
 	Describe("WithCommentAfter", func() {
 		It("sets the after parameter using comment fullname", func() {
 			comment := &reddit.Comment{
@@ -1,9 +1,12 @@
 package reddit
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 )
 
 // Error types for the Reddit client
@@ -14,19 +17,68 @@ var (
 	ErrNotFound           = fmt.Errorf("not found")
 	ErrServerError        = fmt.Errorf("server error")
 	ErrBadRequest         = fmt.Errorf("bad request")
+	ErrForbidden          = fmt.Errorf("forbidden")
+	ErrInvalidSort        = fmt.Errorf("invalid sort")
+	ErrInvalidTimeframe   = fmt.Errorf("invalid timeframe")
+	ErrInvalidVote        = fmt.Errorf("invalid vote direction")
+	ErrTokenQueueFull     = fmt.Errorf("too many callers waiting for a token refresh")
+	ErrClientClosed       = fmt.Errorf("client is closed")
+	ErrEmptyResponse      = fmt.Errorf("empty response body")
+	ErrResponseTooLarge   = fmt.Errorf("response body too large")
+
+	// ErrNSFWGate is returned when Reddit refuses NSFW content because the authenticated account
+	// has not opted into it. Enable "I am over eighteen" (the over_18 preference) on the account,
+	// and use a token with the read scope. It is matched alongside ErrForbidden.
+	ErrNSFWGate = fmt.Errorf("nsfw content is gated: the account must have over_18 enabled")
+
+	// ErrInvalidSubredditName is returned before any request is made when a subreddit name is
+	// empty, longer than 21 characters or contains characters other than letters, digits and
+	// underscores.
+	ErrInvalidSubredditName = fmt.Errorf("invalid subreddit name")
+
+	// ErrInvalidScope is returned by NewAuth when WithAuthScopes is given a scope Reddit does not
+	// define
+	ErrInvalidScope = fmt.Errorf("invalid oauth scope")
 )
 
-// APIError represents an error returned by the Reddit API
+// nsfwGateReason is the reason Reddit gives when refusing NSFW content to an account that has
+// not opted into it
+const nsfwGateReason = "over18"
+
+// APIError represents an error returned by the Reddit API.
+//
+// Every HTTP-level failure returned by the client is, or wraps, an *APIError, so callers can
+// recover it with errors.As and branch on StatusCode:
+//   - non-2xx responses carry the response status code and body
+//   - responses that cannot be read or decoded carry the response status code
+//   - network failures, where no response was received, have a StatusCode of 0
+//
+// The underlying cause remains available through errors.Is and errors.As.
 type APIError struct {
 	StatusCode int
 	Message    string
+	Reason     string        // machine-readable reason from Reddit's error body (such as "private"), if any
+	RetryAfter time.Duration // how long the Retry-After header asked clients to wait, 0 if absent
 	Response   []byte
+	err        error // sentinel error matching the status code, or the underlying cause
+}
+
+// errorBody mirrors the JSON body Reddit sends with most non-2xx responses
+type errorBody struct {
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
 }
 
 func (e *APIError) Error() string {
 	return fmt.Sprintf("reddit API error: status=%d message=%s", e.StatusCode, e.Message)
 }
 
+// Unwrap returns the sentinel error matching the status code so that
+// errors.Is(err, ErrNotFound) and friends work on API errors
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
 // NewAPIError creates a new APIError from an HTTP response
 func NewAPIError(resp *http.Response, body []byte) error {
 	var baseErr error
@@ -39,35 +91,114 @@ func NewAPIError(resp *http.Response, body []byte) error {
 		baseErr = ErrNotFound
 	case http.StatusBadRequest:
 		baseErr = ErrBadRequest
+	case http.StatusForbidden:
+		baseErr = ErrForbidden
 	default:
 		if resp.StatusCode >= 500 {
 			baseErr = ErrServerError
 		}
 	}
 
+	// Fall back to the standard status text for status codes without a sentinel
+	message := strings.ToLower(http.StatusText(resp.StatusCode))
+	if baseErr != nil {
+		message = baseErr.Error()
+	}
+
+	// Reddit explains most refusals in a JSON body; bodies that aren't JSON are ignored
+	var parsed errorBody
+	_ = json.Unmarshal(body, &parsed)
+
+	if resp.StatusCode == http.StatusForbidden && parsed.Reason == nsfwGateReason {
+		baseErr = fmt.Errorf("%w: %w", ErrNSFWGate, ErrForbidden)
+		message = ErrNSFWGate.Error()
+	}
+
 	return &APIError{
 		StatusCode: resp.StatusCode,
-		Message:    baseErr.Error(),
+		Message:    message,
+		Reason:     parsed.Reason,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		Response:   body,
+		err:        baseErr,
+	}
+}
+
+// wrapAPIError wraps a failure that did not come with an API error response, such as a network
+// or decoding failure, so that it satisfies the APIError contract
+func wrapAPIError(statusCode int, message string, err error) *APIError {
+	return &APIError{
+		StatusCode: statusCode,
+		Message:    fmt.Sprintf("%s: %v", message, err),
+		err:        err,
+	}
+}
+
+// jsonErrorEnvelope mirrors the body write endpoints answer with, even on success:
+// {"json": {"errors": [[code, message, field], ...]}}
+type jsonErrorEnvelope struct {
+	JSON struct {
+		Errors [][]any `json:"errors"`
+	} `json:"json"`
+}
+
+// jsonErrorCodes maps the error codes of the json.errors envelope to sentinel errors. Codes that
+// are not listed are reported as ErrBadRequest.
+var jsonErrorCodes = map[string]error{
+	"RATELIMIT":         ErrRateLimited,
+	"SUBREDDIT_NOEXIST": ErrNotFound,
+	"USER_REQUIRED":     ErrInvalidCredentials,
+}
+
+// parseJSONErrors converts a non-empty errors array in the json.errors envelope of a response
+// body into an *APIError. Its Reason holds the code of the first error, such as "RATELIMIT", and
+// it wraps the matching sentinel error. Bodies without the envelope are not an error.
+func parseJSONErrors(statusCode int, body []byte) error {
+	var envelope jsonErrorEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil || len(envelope.JSON.Errors) == 0 {
+		return nil
+	}
+
+	var code, message string
+	entry := envelope.JSON.Errors[0]
+	if len(entry) > 0 {
+		code, _ = entry[0].(string)
+	}
+	if len(entry) > 1 {
+		message, _ = entry[1].(string)
+	}
+
+	baseErr, ok := jsonErrorCodes[code]
+	if !ok {
+		baseErr = ErrBadRequest
+	}
+
+	return &APIError{
+		StatusCode: statusCode,
+		Message:    fmt.Sprintf("%s: %s: %s", baseErr, code, message),
+		Reason:     code,
 		Response:   body,
+		err:        baseErr,
 	}
 }
 
-// IsRateLimitError returns true if the error is a rate limit error
+// IsRateLimitError returns true if the error is, or wraps, a rate limit error, including a
+// RATELIMIT error Reddit reports inside a 200 response to a write action
 func IsRateLimitError(err error) bool {
 	if err == nil {
 		return false
 	}
 	var apiErr *APIError
-	return err == ErrRateLimited || (errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests)
+	return errors.Is(err, ErrRateLimited) || (errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests)
 }
 
-// IsNotFoundError returns true if the error is a not found error
+// IsNotFoundError returns true if the error is, or wraps, a not found error
 func IsNotFoundError(err error) bool {
 	if err == nil {
 		return false
 	}
 	var apiErr *APIError
-	return err == ErrNotFound || (errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound)
+	return errors.Is(err, ErrNotFound) || (errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound)
 }
 
 // IsUnauthorizedError returns true if the error is an unauthorized error
@@ -79,6 +210,25 @@ func IsUnauthorizedError(err error) bool {
 	return err == ErrInvalidCredentials || (errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized)
 }
 
+// IsForbiddenError returns true if the error is, or wraps, a forbidden error, such as a private
+// subreddit or a token missing the scope an endpoint requires
+func IsForbiddenError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *APIError
+	return errors.Is(err, ErrForbidden) || (errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden)
+}
+
+// IsBadRequestError returns true if the error is, or wraps, a bad request error
+func IsBadRequestError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *APIError
+	return errors.Is(err, ErrBadRequest) || (errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest)
+}
+
 // IsServerError returns true if the error is a server error
 func IsServerError(err error) bool {
 	if err == nil {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/JohnPlummer/reddit-client/reddit"
 	. "github.com/onsi/ginkgo/v2"
@@ -68,6 +69,36 @@ var _ = Describe("Errors", func() {
 				Expect(apiErr.Message).To(Equal("rate limited"))
 				Expect(apiErr.Response).To(Equal(responseBody))
 			})
+
+			It("records the Retry-After header in seconds", func() {
+				resp := &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{"Retry-After": []string{"5"}},
+				}
+				err := reddit.NewAPIError(resp, responseBody)
+
+				apiErr := err.(*reddit.APIError)
+				Expect(apiErr.RetryAfter).To(Equal(5 * time.Second))
+			})
+
+			It("records the Retry-After header as an HTTP date", func() {
+				retryAt := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+				resp := &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     http.Header{"Retry-After": []string{retryAt}},
+				}
+				err := reddit.NewAPIError(resp, responseBody)
+
+				apiErr := err.(*reddit.APIError)
+				Expect(apiErr.RetryAfter).To(BeNumerically("~", time.Minute, 2*time.Second))
+			})
+
+			It("leaves RetryAfter zero without the header", func() {
+				resp := &http.Response{StatusCode: http.StatusTooManyRequests}
+				err := reddit.NewAPIError(resp, responseBody)
+
+				Expect(err.(*reddit.APIError).RetryAfter).To(BeZero())
+			})
 		})
 
 		Context("with 404 Not Found", func() {
@@ -93,6 +124,7 @@ var _ = Describe("Errors", func() {
 				Expect(apiErr.StatusCode).To(Equal(http.StatusBadRequest))
 				Expect(apiErr.Message).To(Equal("bad request"))
 				Expect(apiErr.Response).To(Equal(responseBody))
+				Expect(reddit.IsBadRequestError(err)).To(BeTrue())
 			})
 		})
 
@@ -135,26 +167,81 @@ var _ = Describe("Errors", func() {
 			})
 		})
 
+		Context("with 403 Forbidden", func() {
+			It("creates APIError with forbidden message", func() {
+				resp := &http.Response{StatusCode: http.StatusForbidden}
+				err := reddit.NewAPIError(resp, responseBody)
+
+				Expect(err).To(BeAssignableToTypeOf(&reddit.APIError{}))
+				apiErr := err.(*reddit.APIError)
+				Expect(apiErr.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(apiErr.Message).To(Equal("forbidden"))
+				Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+				Expect(errors.Is(err, reddit.ErrNSFWGate)).To(BeFalse())
+				Expect(reddit.IsForbiddenError(err)).To(BeTrue())
+			})
+
+			It("parses the reason from the error body", func() {
+				resp := &http.Response{StatusCode: http.StatusForbidden}
+				err := reddit.NewAPIError(resp, []byte(`{"reason": "private", "message": "Forbidden", "error": 403}`))
+
+				apiErr := err.(*reddit.APIError)
+				Expect(apiErr.Reason).To(Equal("private"))
+				Expect(apiErr.Message).To(Equal("forbidden"))
+			})
+
+			It("reports the NSFW gate when the account has not opted into over18 content", func() {
+				resp := &http.Response{StatusCode: http.StatusForbidden}
+				err := reddit.NewAPIError(resp, []byte(`{"reason": "over18", "message": "Forbidden", "error": 403}`))
+
+				apiErr := err.(*reddit.APIError)
+				Expect(apiErr.Reason).To(Equal("over18"))
+				Expect(apiErr.Message).To(ContainSubstring("over_18"))
+				Expect(errors.Is(err, reddit.ErrNSFWGate)).To(BeTrue())
+				Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+			})
+		})
+
 		Context("with unhandled status codes", func() {
-			It("panics for 2xx status when trying to call Error() on nil baseErr", func() {
+			It("uses the status text for 2xx status", func() {
 				resp := &http.Response{StatusCode: http.StatusOK}
-				Expect(func() {
-					reddit.NewAPIError(resp, responseBody)
-				}).To(Panic())
+				err := reddit.NewAPIError(resp, responseBody)
+
+				apiErr := err.(*reddit.APIError)
+				Expect(apiErr.Message).To(Equal("ok"))
+				Expect(errors.Unwrap(err)).To(BeNil())
 			})
 
-			It("panics for 3xx status when trying to call Error() on nil baseErr", func() {
+			It("uses the status text for 3xx status", func() {
 				resp := &http.Response{StatusCode: http.StatusMovedPermanently}
-				Expect(func() {
-					reddit.NewAPIError(resp, responseBody)
-				}).To(Panic())
+				err := reddit.NewAPIError(resp, responseBody)
+
+				apiErr := err.(*reddit.APIError)
+				Expect(apiErr.Message).To(Equal("moved permanently"))
+				Expect(errors.Unwrap(err)).To(BeNil())
 			})
 
-			It("panics for 4xx status (not handled) when trying to call Error() on nil baseErr", func() {
-				resp := &http.Response{StatusCode: http.StatusForbidden}
-				Expect(func() {
-					reddit.NewAPIError(resp, responseBody)
-				}).To(Panic())
+			It("uses the status text for unhandled 4xx status", func() {
+				resp := &http.Response{StatusCode: http.StatusConflict}
+				err := reddit.NewAPIError(resp, responseBody)
+
+				apiErr := err.(*reddit.APIError)
+				Expect(apiErr.StatusCode).To(Equal(http.StatusConflict))
+				Expect(apiErr.Message).To(Equal("conflict"))
+			})
+		})
+
+		Context("with sentinel errors", func() {
+			It("unwraps to the sentinel matching the status code", func() {
+				Expect(errors.Is(reddit.NewAPIError(&http.Response{StatusCode: http.StatusNotFound}, nil), reddit.ErrNotFound)).To(BeTrue())
+				Expect(errors.Is(reddit.NewAPIError(&http.Response{StatusCode: http.StatusTooManyRequests}, nil), reddit.ErrRateLimited)).To(BeTrue())
+				Expect(errors.Is(reddit.NewAPIError(&http.Response{StatusCode: http.StatusBadGateway}, nil), reddit.ErrServerError)).To(BeTrue())
+			})
+
+			It("unwraps through wrapped API errors", func() {
+				err := fmt.Errorf("operation failed: %w", reddit.NewAPIError(&http.Response{StatusCode: http.StatusForbidden}, nil))
+				Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+				Expect(errors.Is(err, reddit.ErrNotFound)).To(BeFalse())
 			})
 		})
 
@@ -195,9 +282,9 @@ var _ = Describe("Errors", func() {
 				Expect(reddit.IsRateLimitError(reddit.ErrRateLimited)).To(BeTrue())
 			})
 
-			It("returns false for wrapped error (direct equality check only)", func() {
+			It("returns true for wrapped error", func() {
 				wrappedErr := fmt.Errorf("wrapped: %w", reddit.ErrRateLimited)
-				Expect(reddit.IsRateLimitError(wrappedErr)).To(BeFalse())
+				Expect(reddit.IsRateLimitError(wrappedErr)).To(BeTrue())
 			})
 		})
 
@@ -257,9 +344,9 @@ var _ = Describe("Errors", func() {
 				Expect(reddit.IsNotFoundError(reddit.ErrNotFound)).To(BeTrue())
 			})
 
-			It("returns false for wrapped error (direct equality check only)", func() {
+			It("returns true for wrapped error", func() {
 				wrappedErr := fmt.Errorf("wrapped: %w", reddit.ErrNotFound)
-				Expect(reddit.IsNotFoundError(wrappedErr)).To(BeFalse())
+				Expect(reddit.IsNotFoundError(wrappedErr)).To(BeTrue())
 			})
 		})
 
@@ -307,6 +394,124 @@ var _ = Describe("Errors", func() {
 		})
 	})
 
+	Describe("IsForbiddenError", func() {
+		Context("with nil error", func() {
+			It("returns false", func() {
+				Expect(reddit.IsForbiddenError(nil)).To(BeFalse())
+			})
+		})
+
+		Context("with ErrForbidden", func() {
+			It("returns true for direct error", func() {
+				Expect(reddit.IsForbiddenError(reddit.ErrForbidden)).To(BeTrue())
+			})
+
+			It("returns true for wrapped error", func() {
+				wrappedErr := fmt.Errorf("wrapped: %w", reddit.ErrForbidden)
+				Expect(reddit.IsForbiddenError(wrappedErr)).To(BeTrue())
+			})
+		})
+
+		Context("with APIError", func() {
+			It("returns true for 403 status code", func() {
+				apiErr := &reddit.APIError{
+					StatusCode: http.StatusForbidden,
+					Message:    "forbidden",
+				}
+				Expect(reddit.IsForbiddenError(apiErr)).To(BeTrue())
+			})
+
+			It("returns true for wrapped APIError with 403 status", func() {
+				apiErr := &reddit.APIError{
+					StatusCode: http.StatusForbidden,
+					Message:    "forbidden",
+				}
+				wrappedErr := fmt.Errorf("API call failed: %w", apiErr)
+				Expect(reddit.IsForbiddenError(wrappedErr)).To(BeTrue())
+			})
+
+			It("returns false for APIError with different status code", func() {
+				apiErr := &reddit.APIError{
+					StatusCode: http.StatusNotFound,
+					Message:    "not found",
+				}
+				Expect(reddit.IsForbiddenError(apiErr)).To(BeFalse())
+			})
+		})
+
+		Context("with other errors", func() {
+			It("returns false for unrelated error", func() {
+				err := errors.New("some random error")
+				Expect(reddit.IsForbiddenError(err)).To(BeFalse())
+			})
+
+			It("returns false for other predefined errors", func() {
+				Expect(reddit.IsForbiddenError(reddit.ErrBadRequest)).To(BeFalse())
+				Expect(reddit.IsForbiddenError(reddit.ErrNotFound)).To(BeFalse())
+				Expect(reddit.IsForbiddenError(reddit.ErrInvalidCredentials)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("IsBadRequestError", func() {
+		Context("with nil error", func() {
+			It("returns false", func() {
+				Expect(reddit.IsBadRequestError(nil)).To(BeFalse())
+			})
+		})
+
+		Context("with ErrBadRequest", func() {
+			It("returns true for direct error", func() {
+				Expect(reddit.IsBadRequestError(reddit.ErrBadRequest)).To(BeTrue())
+			})
+
+			It("returns true for wrapped error", func() {
+				wrappedErr := fmt.Errorf("wrapped: %w", reddit.ErrBadRequest)
+				Expect(reddit.IsBadRequestError(wrappedErr)).To(BeTrue())
+			})
+		})
+
+		Context("with APIError", func() {
+			It("returns true for 400 status code", func() {
+				apiErr := &reddit.APIError{
+					StatusCode: http.StatusBadRequest,
+					Message:    "bad request",
+				}
+				Expect(reddit.IsBadRequestError(apiErr)).To(BeTrue())
+			})
+
+			It("returns true for wrapped APIError with 400 status", func() {
+				apiErr := &reddit.APIError{
+					StatusCode: http.StatusBadRequest,
+					Message:    "bad request",
+				}
+				wrappedErr := fmt.Errorf("API call failed: %w", apiErr)
+				Expect(reddit.IsBadRequestError(wrappedErr)).To(BeTrue())
+			})
+
+			It("returns false for APIError with different status code", func() {
+				apiErr := &reddit.APIError{
+					StatusCode: http.StatusNotFound,
+					Message:    "not found",
+				}
+				Expect(reddit.IsBadRequestError(apiErr)).To(BeFalse())
+			})
+		})
+
+		Context("with other errors", func() {
+			It("returns false for unrelated error", func() {
+				err := errors.New("some random error")
+				Expect(reddit.IsBadRequestError(err)).To(BeFalse())
+			})
+
+			It("returns false for other predefined errors", func() {
+				Expect(reddit.IsBadRequestError(reddit.ErrForbidden)).To(BeFalse())
+				Expect(reddit.IsBadRequestError(reddit.ErrNotFound)).To(BeFalse())
+				Expect(reddit.IsBadRequestError(reddit.ErrServerError)).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("IsServerError", func() {
 		Context("with nil error", func() {
 			It("returns false", func() {
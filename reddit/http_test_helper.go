@@ -22,6 +22,7 @@ func NewTestTransport() *TestTransport {
 		callHistory:   make([]string, 0),
 		errorOnCall:   make(map[int]error),
 		responseQueue: make(map[string][]*http.Response),
+		fallbacks:     make(map[string]*TestResponse),
 	}
 }
 
@@ -33,6 +34,7 @@ type TestTransport struct {
 	callHistory   []string                    // Track which paths were called
 	errorOnCall   map[int]error               // Map from call number to error
 	responseQueue map[string][]*http.Response // Queue of responses for a path
+	fallbacks     map[string]*TestResponse    // Served for a path once its queue is drained
 }
 
 // Ensure TestTransport implements both interfaces
@@ -83,6 +85,15 @@ func (m *TestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		}, nil
 	}
 
+	// Serve the fallback once the queue is drained, as often as it is requested
+	if fallback, ok := m.fallbacks[pathKey]; ok {
+		return &http.Response{
+			StatusCode: fallback.StatusCode,
+			Body:       io.NopCloser(bytes.NewReader(fallback.Body)),
+			Header:     fallback.Headers.Clone(),
+		}, nil
+	}
+
 	// For API endpoints, try to match the path
 	if resp, ok := m.responses[req.URL.Path]; ok {
 		// Return a new response with a fresh body for each request
@@ -132,6 +143,32 @@ func (m *TestTransport) AddResponseToQueue(path string, resp *http.Response) {
 	m.responseQueue[path] = append(m.responseQueue[path], resp)
 }
 
+// AddResponseWithFallback queues responses for a path like AddResponseToQueue and serves fallback
+// for every request to the path once the queue is drained. Unlike responses added with AddResponse,
+// the fallback can be served any number of times. The queue is served before responses added with
+// AddResponse, and the fallback takes precedence over them. Calling it again for the same path
+// appends to the queue and replaces the fallback.
+func (m *TestTransport) AddResponseWithFallback(path string, fallback *http.Response, queue ...*http.Response) {
+	for _, resp := range queue {
+		m.AddResponseToQueue(path, resp)
+	}
+
+	body, err := io.ReadAll(fallback.Body)
+	if err != nil {
+		panic(err)
+	}
+	fallback.Body.Close()
+
+	if m.fallbacks == nil {
+		m.fallbacks = make(map[string]*TestResponse)
+	}
+	m.fallbacks[path] = &TestResponse{
+		StatusCode: fallback.StatusCode,
+		Body:       body,
+		Headers:    fallback.Header,
+	}
+}
+
 // GetCallCount returns the number of calls made
 func (m *TestTransport) GetCallCount() int {
 	return m.callCount
@@ -150,6 +187,7 @@ func (m *TestTransport) Reset() {
 	m.callHistory = make([]string, 0)
 	m.errorOnCall = make(map[int]error)
 	m.responseQueue = make(map[string][]*http.Response)
+	m.fallbacks = make(map[string]*TestResponse)
 }
 
 // CreateJSONResponse creates an HTTP response with JSON body
@@ -0,0 +1,118 @@
+package reddit_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/JohnPlummer/reddit-client/reddit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TestTransport", func() {
+	var (
+		transport *reddit.TestTransport
+		client    *http.Client
+	)
+
+	BeforeEach(func() {
+		transport = reddit.NewTestTransport()
+		client = &http.Client{Transport: transport}
+	})
+
+	textResponse := func(status int, body string) *http.Response {
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}
+	}
+
+	get := func(path string) (int, string) {
+		resp, err := client.Get("https://oauth.reddit.com" + path)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return resp.StatusCode, string(body)
+	}
+
+	Describe("AddResponseWithFallback", func() {
+		It("serves the fallback repeatedly once the queue is drained", func() {
+			transport.AddResponseWithFallback("/r/golang.json",
+				textResponse(http.StatusOK, "fallback"),
+				textResponse(http.StatusServiceUnavailable, "first"),
+				textResponse(http.StatusBadGateway, "second"),
+			)
+
+			status, body := get("/r/golang.json")
+			Expect(status).To(Equal(http.StatusServiceUnavailable))
+			Expect(body).To(Equal("first"))
+
+			status, body = get("/r/golang.json")
+			Expect(status).To(Equal(http.StatusBadGateway))
+			Expect(body).To(Equal("second"))
+
+			for i := 0; i < 3; i++ {
+				status, body = get("/r/golang.json")
+				Expect(status).To(Equal(http.StatusOK))
+				Expect(body).To(Equal("fallback"))
+			}
+		})
+
+		It("serves only the fallback without a queue", func() {
+			transport.AddResponseWithFallback("/r/golang.json", textResponse(http.StatusOK, "fallback"))
+
+			for i := 0; i < 2; i++ {
+				_, body := get("/r/golang.json")
+				Expect(body).To(Equal("fallback"))
+			}
+		})
+
+		It("takes precedence over AddResponse and leaves other paths alone", func() {
+			transport.AddResponse("/r/golang.json", textResponse(http.StatusOK, "single"))
+			transport.AddResponse("/r/rust.json", textResponse(http.StatusOK, "rust"))
+			transport.AddResponseWithFallback("/r/golang.json", textResponse(http.StatusOK, "fallback"))
+
+			_, body := get("/r/golang.json")
+			Expect(body).To(Equal("fallback"))
+			_, body = get("/r/rust.json")
+			Expect(body).To(Equal("rust"))
+		})
+
+		It("is cleared by Reset", func() {
+			transport.AddResponseWithFallback("/r/golang.json", textResponse(http.StatusOK, "fallback"))
+			transport.Reset()
+
+			_, body := get("/r/golang.json")
+			Expect(body).To(BeEmpty())
+		})
+
+		It("simplifies retry tests", func() {
+			auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+			Expect(err).NotTo(HaveOccurred())
+			redditClient, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(client),
+				reddit.WithRetries(2),
+				reddit.WithRetryDelay(time.Millisecond),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			transport.AddResponseWithFallback("/r/golang.json",
+				reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{map[string]any{"data": map[string]any{"id": "post1", "title": "Post"}}},
+					},
+				}),
+				textResponse(http.StatusServiceUnavailable, `{"error": "unavailable"}`),
+			)
+
+			subreddit := reddit.NewSubreddit("golang", redditClient)
+			for i := 0; i < 2; i++ {
+				posts, err := subreddit.GetPosts(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(1))
+			}
+			Expect(redditClient.RequestCount()).To(Equal(3))
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package reddit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Listing is a decoded page of a Reddit listing, the envelope Reddit wraps collections such as
+// posts and comments in:
+//
+//	{"kind": "Listing", "data": {"children": [{"kind": "t3", "data": {...}}], "after": "t3_abc", "before": null}}
+//
+// After and Before are the cursors of the neighbouring pages, empty when there is none.
+type Listing[T any] struct {
+	Items  []T
+	After  string
+	Before string
+}
+
+// ListingItemDecoder decodes the data object of a single listing child. kind is the child's kind,
+// such as "t3" for a post or "t1" for a comment, and may be empty in hand-written responses.
+type ListingItemDecoder[T any] func(kind string, data json.RawMessage) (T, error)
+
+// listingEnvelope mirrors the JSON of a listing, leaving each child's data to the item decoder
+type listingEnvelope struct {
+	Kind string `json:"kind"`
+	Data *struct {
+		Children []listingChild `json:"children"`
+		After    string         `json:"after"`
+		Before   string         `json:"before"`
+	} `json:"data"`
+}
+
+// listingChild is a single thing of a listing
+type listingChild struct {
+	Kind string          `json:"kind"`
+	Data json.RawMessage `json:"data"`
+}
+
+// DecodeListing decodes a listing from a response body, such as one seen by a body interceptor.
+// Each child is decoded with decode, or unmarshalled into T when decode is nil. Children that fail
+// to decode are skipped rather than failing the whole page, and kinds other than "Listing" are
+// rejected.
+func DecodeListing[T any](data []byte, decode ListingItemDecoder[T]) (*Listing[T], error) {
+	var envelope listingEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("listing.DecodeListing: decoding envelope failed: %w", err)
+	}
+	if envelope.Kind != "" && envelope.Kind != "Listing" {
+		return nil, fmt.Errorf("listing.DecodeListing: unexpected kind %q", envelope.Kind)
+	}
+	if envelope.Data == nil {
+		return nil, fmt.Errorf("listing.DecodeListing: invalid response format missing data object")
+	}
+	if envelope.Data.Children == nil {
+		return nil, fmt.Errorf("listing.DecodeListing: invalid response format missing children array")
+	}
+
+	if decode == nil {
+		decode = func(_ string, data json.RawMessage) (T, error) {
+			var item T
+			err := json.Unmarshal(data, &item)
+			return item, err
+		}
+	}
+
+	listing := &Listing[T]{After: envelope.Data.After, Before: envelope.Data.Before}
+	for _, child := range envelope.Data.Children {
+		item, err := decode(child.Kind, child.Data)
+		if err != nil {
+			continue // Skip invalid items instead of failing completely
+		}
+		listing.Items = append(listing.Items, item)
+	}
+	return listing, nil
+}
@@ -0,0 +1,112 @@
+package reddit_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/JohnPlummer/reddit-client/reddit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DecodeListing", func() {
+	postListing := []byte(`{
+		"kind": "Listing",
+		"data": {
+			"after": "t3_post2",
+			"before": null,
+			"children": [
+				{"kind": "t3", "data": {"id": "post1", "title": "First Post", "score": 42, "num_comments": 7, "created_utc": 1700000000}},
+				{"kind": "t3", "data": {"id": "post2", "title": "Second Post", "over_18": true}}
+			]
+		}
+	}`)
+
+	commentListing := []byte(`{
+		"kind": "Listing",
+		"data": {
+			"after": null,
+			"before": "t1_c0",
+			"children": [
+				{"kind": "t1", "data": {"id": "c1", "author": "alice", "body": "First!", "parent_id": "t3_post1", "link_id": "t3_post1"}},
+				{"kind": "t1", "data": {"id": "c2", "author": "bob", "body": "Reply", "parent_id": "t1_c1", "link_id": "t3_post1"}},
+				{"kind": "more", "data": {"id": "c3", "count": 12, "children": ["c3", "c4"]}}
+			]
+		}
+	}`)
+
+	It("decodes posts with their cursors", func() {
+		listing, err := reddit.DecodeListing[reddit.Post](postListing, nil)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listing.After).To(Equal("t3_post2"))
+		Expect(listing.Before).To(BeEmpty())
+		Expect(listing.Items).To(HaveLen(2))
+		Expect(listing.Items[0]).To(And(
+			HaveField("ID", "post1"),
+			HaveField("Title", "First Post"),
+			HaveField("RedditScore", 42),
+			HaveField("CommentCount", 7),
+			HaveField("Created", int64(1700000000)),
+		))
+		Expect(listing.Items[1].Over18).To(BeTrue())
+	})
+
+	It("decodes comments with their cursors", func() {
+		listing, err := reddit.DecodeListing[reddit.Comment](commentListing, nil)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listing.After).To(BeEmpty())
+		Expect(listing.Before).To(Equal("t1_c0"))
+		Expect(listing.Items[0]).To(And(
+			HaveField("ID", "c1"),
+			HaveField("Author", "alice"),
+			HaveField("ParentID", "t3_post1"),
+		))
+		Expect(listing.Items[1].ParentID).To(Equal("t1_c1"))
+	})
+
+	It("passes each child's kind to the decoder and skips the children it rejects", func() {
+		var kinds []string
+		decodeComment := func(kind string, data json.RawMessage) (reddit.Comment, error) {
+			kinds = append(kinds, kind)
+			if kind != "t1" {
+				return reddit.Comment{}, errors.New("not a comment")
+			}
+			var comment reddit.Comment
+			err := json.Unmarshal(data, &comment)
+			return comment, err
+		}
+
+		listing, err := reddit.DecodeListing(commentListing, decodeComment)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kinds).To(Equal([]string{"t1", "t1", "more"}))
+		Expect(listing.Items).To(HaveLen(2))
+		Expect(listing.Items[1].ID).To(Equal("c2"))
+	})
+
+	It("skips children that do not fit the item type", func() {
+		data := []byte(`{"data": {"children": [{"data": {"id": "post1"}}, {"data": {"id": 12}}, {"data": "oops"}]}}`)
+
+		listing, err := reddit.DecodeListing[reddit.Post](data, nil)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listing.Items).To(HaveLen(1))
+		Expect(listing.Items[0].ID).To(Equal("post1"))
+	})
+
+	It("rejects responses that are not listings", func() {
+		_, err := reddit.DecodeListing[reddit.Post]([]byte(`{"kind": "t3", "data": {"id": "post1"}}`), nil)
+		Expect(err).To(MatchError(ContainSubstring(`unexpected kind "t3"`)))
+
+		_, err = reddit.DecodeListing[reddit.Post]([]byte(`{"kind": "Listing"}`), nil)
+		Expect(err).To(MatchError(ContainSubstring("missing data object")))
+
+		_, err = reddit.DecodeListing[reddit.Post]([]byte(`{"data": {"after": "t3_x"}}`), nil)
+		Expect(err).To(MatchError(ContainSubstring("missing children array")))
+
+		_, err = reddit.DecodeListing[reddit.Post]([]byte(`[{"kind": "Listing"}]`), nil)
+		Expect(err).To(MatchError(ContainSubstring("decoding envelope failed")))
+	})
+})
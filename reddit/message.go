@@ -0,0 +1,149 @@
+package reddit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Message represents an item in the authenticated user's inbox: either a private message or,
+// when WasComment is true, a reply or username mention left as a comment
+type Message struct {
+	ID         string    `json:"id"`
+	Author     string    `json:"author"`
+	Subject    string    `json:"subject"`
+	Body       string    `json:"body"`
+	WasComment bool      `json:"was_comment"` // true for comment replies and mentions, false for private messages
+	Created    int64     `json:"created_utc"`
+	CreatedUTC time.Time `json:"-"` // Created as a time, zero if Reddit sent no timestamp
+}
+
+// Fullname returns the Reddit fullname identifier for this message: t1_<id> for comment replies
+// and mentions, t4_<id> for private messages
+func (m Message) Fullname() string {
+	if m.WasComment {
+		return "t1_" + m.ID
+	}
+	return "t4_" + m.ID
+}
+
+// InboxOption is a function type for modifying inbox request parameters
+type InboxOption func(params map[string]string)
+
+// WithInboxLimit returns an InboxOption that sets the maximum number of messages to fetch (100 by default)
+func WithInboxLimit(limit int) InboxOption {
+	return func(params map[string]string) {
+		if limit > 0 {
+			params["limit"] = strconv.Itoa(limit)
+		}
+	}
+}
+
+// GetInbox fetches the authenticated user's inbox, newest first, following pagination until the
+// inbox is exhausted or the WithInboxLimit limit is reached. It requires user context
+// authentication (see NewAuthWithRefreshToken) with the privatemessages scope; other tokens get
+// an error wrapping ErrForbidden.
+func (c *Client) GetInbox(ctx context.Context, opts ...InboxOption) ([]Message, error) {
+	params := map[string]string{
+		"limit": "100", // Default limit
+	}
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	limit, _ := strconv.Atoi(params["limit"])
+
+	fetchPage := func(ctx context.Context, after string) ([]Message, string, error) {
+		if after != "" {
+			params["after"] = after
+		}
+		return c.getInboxPage(ctx, params)
+	}
+
+	paginationOpts := c.paginationOptions()
+	paginationOpts.Limit = limit
+
+	messages, err := PaginateAll(ctx, fetchPage, paginationOpts)
+	if err != nil {
+		return messages, fmt.Errorf("client.GetInbox: %w", err)
+	}
+	return messages, nil
+}
+
+// getInboxPage fetches a single page of the authenticated user's inbox
+func (c *Client) getInboxPage(ctx context.Context, params map[string]string) ([]Message, string, error) {
+	c.setListingParams(params)
+	endpoint := BuildEndpoint("/message/inbox.json", params)
+
+	var data map[string]any
+	if err := c.requestJSON(ctx, "GET", endpoint, nil, &data); err != nil {
+		return nil, "", fmt.Errorf("client.getInboxPage: %w", err)
+	}
+
+	return parseMessages(data)
+}
+
+// parseMessages extracts the messages and the next page's after token from an inbox listing
+func parseMessages(data map[string]any) ([]Message, string, error) {
+	listing, ok := data["data"].(map[string]any)
+	if !ok {
+		return nil, "", fmt.Errorf("message.parseMessages: invalid response format missing data object")
+	}
+
+	children, ok := listing["children"].([]any)
+	if !ok {
+		return nil, "", fmt.Errorf("message.parseMessages: invalid response format missing children array")
+	}
+
+	var messages []Message
+	for _, item := range children {
+		itemMap, ok := item.(map[string]any)
+		if !ok {
+			continue // Skip invalid items
+		}
+
+		messageData, ok := itemMap["data"].(map[string]any)
+		if !ok {
+			continue // Skip invalid message data
+		}
+
+		message, err := parseMessageData(messageData)
+		if err != nil {
+			continue // Skip messages with missing essential data
+		}
+		messages = append(messages, message)
+	}
+
+	nextPage, _ := listing["after"].(string)
+	return messages, nextPage, nil
+}
+
+// parseMessageData converts the data object of an inbox listing child into a Message
+func parseMessageData(data map[string]any) (Message, error) {
+	id := getStringField(data, "id")
+	if id == "" {
+		return Message{}, fmt.Errorf("message.parseMessageData: missing required field 'id'")
+	}
+
+	author := getStringField(data, "author")
+	subject := getStringField(data, "subject")
+	body := getStringField(data, "body")
+	wasComment := getBoolField(data, "was_comment")
+	created := getInt64Field(data, "created_utc")
+
+	var createdUTC time.Time
+	if created > 0 {
+		createdUTC = time.Unix(created, 0).UTC()
+	}
+
+	return Message{
+		ID:         id,
+		Author:     author,
+		Subject:    subject,
+		Body:       body,
+		WasComment: wasComment,
+		Created:    created,
+		CreatedUTC: createdUTC,
+	}, nil
+}
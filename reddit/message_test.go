@@ -0,0 +1,104 @@
+package reddit_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/JohnPlummer/reddit-client/reddit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Inbox", func() {
+	var (
+		transport *reddit.TestTransport
+		client    *reddit.Client
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		transport = reddit.NewTestTransport()
+		auth, err := reddit.NewAuth("test_client_id", "test_client_secret",
+			reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err = reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: transport}))
+		Expect(err).NotTo(HaveOccurred())
+		ctx = context.Background()
+	})
+
+	inboxPage := func(after string, children ...map[string]any) *http.Response {
+		items := make([]any, 0, len(children))
+		for _, child := range children {
+			items = append(items, child)
+		}
+		return reddit.CreateJSONResponse(map[string]any{
+			"kind": "Listing",
+			"data": map[string]any{"children": items, "after": after},
+		})
+	}
+
+	Describe("GetInbox", func() {
+		It("parses private messages and comment replies", func() {
+			transport.AddResponse("/message/inbox.json", inboxPage("",
+				map[string]any{"kind": "t4", "data": map[string]any{
+					"id":          "msg1",
+					"author":      "alice",
+					"subject":     "Hello",
+					"body":        "Nice bot!",
+					"was_comment": false,
+					"created_utc": float64(1700000000),
+				}},
+				map[string]any{"kind": "t1", "data": map[string]any{
+					"id":          "com1",
+					"author":      "bob",
+					"subject":     "comment reply",
+					"body":        "u/test-bot what do you think?",
+					"was_comment": true,
+				}},
+			))
+
+			messages, err := client.GetInbox(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(messages).To(HaveLen(2))
+
+			Expect(messages[0].ID).To(Equal("msg1"))
+			Expect(messages[0].Author).To(Equal("alice"))
+			Expect(messages[0].Subject).To(Equal("Hello"))
+			Expect(messages[0].Body).To(Equal("Nice bot!"))
+			Expect(messages[0].WasComment).To(BeFalse())
+			Expect(messages[0].Fullname()).To(Equal("t4_msg1"))
+			Expect(messages[0].CreatedUTC).To(Equal(time.Unix(1700000000, 0).UTC()))
+
+			Expect(messages[1].WasComment).To(BeTrue())
+			Expect(messages[1].Fullname()).To(Equal("t1_com1"))
+			Expect(messages[1].CreatedUTC.IsZero()).To(BeTrue())
+		})
+
+		It("follows pagination up to the limit", func() {
+			transport.AddResponseToQueue("/message/inbox.json", inboxPage("t4_msg2",
+				map[string]any{"data": map[string]any{"id": "msg1"}},
+				map[string]any{"data": map[string]any{"id": "msg2"}},
+			))
+			transport.AddResponseToQueue("/message/inbox.json", inboxPage("t4_msg4",
+				map[string]any{"data": map[string]any{"id": "msg3"}},
+				map[string]any{"data": map[string]any{"id": "msg4"}},
+			))
+
+			messages, err := client.GetInbox(ctx, reddit.WithInboxLimit(3))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(messages).To(HaveLen(3))
+			Expect(messages[2].ID).To(Equal("msg3"))
+			Expect(transport.GetCallHistory()).To(ContainElement(ContainSubstring("after=t4_msg2")))
+		})
+
+		It("returns ErrForbidden without the privatemessages scope", func() {
+			transport.AddResponse("/message/inbox.json", &http.Response{StatusCode: http.StatusForbidden, Body: http.NoBody})
+
+			messages, err := client.GetInbox(ctx)
+			Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+			Expect(messages).To(BeNil())
+		})
+	})
+})
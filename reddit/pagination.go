@@ -2,7 +2,9 @@ package reddit
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 )
 
 // PaginationResult holds the results of a paginated fetch operation
@@ -13,6 +15,8 @@ type PaginationResult[T any] struct {
 
 // FetchPageFunc defines the signature for a function that fetches a single page of items.
 // It should return the items, the "after" token for the next page, and any error.
+// The token is opaque to the paginators, so a fetch function paging backwards can return
+// a "before" token instead.
 type FetchPageFunc[T any] func(ctx context.Context, after string) ([]T, string, error)
 
 // AfterTokenExtractor defines the signature for a function that extracts the "after" token
@@ -33,6 +37,26 @@ type PaginationOptions struct {
 	// Default is true, which prevents infinite loops when the API returns empty pages
 	// but still provides an "after" token.
 	StopOnEmpty bool
+
+	// PartialResultsOnCancel determines what is returned when the context is cancelled or its
+	// deadline expires mid-pagination. When true, the items collected so far are returned
+	// together with an error wrapping the context error. When false, nil is returned.
+	PartialResultsOnCancel bool
+
+	// PartialResultsOnError determines what is returned when a page fails to load for a reason
+	// other than the context, such as a network error. When true, the items collected so far are
+	// returned together with the error. When false, nil is returned.
+	PartialResultsOnError bool
+
+	// Deduplicate skips items whose fullname was already seen earlier in the same pagination call,
+	// such as posts that moved between pages while they were being fetched. Only items with a
+	// Fullname method (Post, Comment) are deduplicated. A page made up entirely of items already
+	// seen counts as empty for StopOnEmpty.
+	Deduplicate bool
+
+	// BufferSize is the capacity of the channel PaginateStream emits items on, letting it fetch
+	// ahead of a slow consumer. The default of 0 makes the channel unbuffered.
+	BufferSize int
 }
 
 // DefaultPaginationOptions returns sensible defaults for pagination
@@ -70,6 +94,10 @@ func PaginateAll[T any](
 		return nil, fmt.Errorf("pagination.PaginateAll: fetchPage function is required")
 	}
 
+	if opts.Deduplicate {
+		fetchPage = deduplicatePages(fetchPage)
+	}
+
 	var allItems []T
 	after := ""
 
@@ -77,6 +105,9 @@ func PaginateAll[T any](
 		// Check context cancellation
 		select {
 		case <-ctx.Done():
+			if opts.PartialResultsOnCancel {
+				return allItems, fmt.Errorf("pagination.PaginateAll: stopped after %d items (after=%q): %w", len(allItems), after, ctx.Err())
+			}
 			return nil, ctx.Err()
 		default:
 		}
@@ -84,7 +115,14 @@ func PaginateAll[T any](
 		// Fetch the next page
 		pageItems, nextAfter, err := fetchPage(ctx, after)
 		if err != nil {
-			return nil, fmt.Errorf("pagination.PaginateAll: fetch page failed (after=%q): %w", after, err)
+			if ctxErr := contextError(ctx, err); ctxErr != nil && opts.PartialResultsOnCancel {
+				return allItems, fmt.Errorf("pagination.PaginateAll: stopped after %d items (after=%q): %w", len(allItems), after, ctxErr)
+			}
+			err = fmt.Errorf("pagination.PaginateAll: fetch page failed (after=%q): %w", after, err)
+			if opts.PartialResultsOnError {
+				return allItems, err
+			}
+			return nil, err
 		}
 
 		// Add items to our collection
@@ -163,6 +201,249 @@ func PaginateAfter[T any](
 	return PaginateAll(ctx, modifiedFetchPage, opts)
 }
 
+// PaginateStream fetches pages like PaginateAll but emits each item on the returned channel as soon
+// as its page has been fetched, instead of accumulating all items in memory.
+//
+// Both channels are closed when pagination ends. If a page fails to load or the context is
+// cancelled, the error is sent on the error channel (which is buffered) before both channels are closed.
+//
+// Example usage:
+//
+//	items, errs := PaginateStream(ctx, fetchPosts, PaginationOptions{Limit: 500})
+//	for post := range items {
+//		process(post)
+//	}
+//	if err := <-errs; err != nil {
+//		return err
+//	}
+func PaginateStream[T any](
+	ctx context.Context,
+	fetchPage FetchPageFunc[T],
+	opts PaginationOptions,
+) (<-chan T, <-chan error) {
+	items := make(chan T, max(opts.BufferSize, 0))
+	errs := make(chan error, 1)
+
+	if fetchPage == nil {
+		errs <- fmt.Errorf("pagination.PaginateStream: fetchPage function is required")
+		close(items)
+		close(errs)
+		return items, errs
+	}
+
+	if opts.Deduplicate {
+		fetchPage = deduplicatePages(fetchPage)
+	}
+
+	go func() {
+		defer close(errs)
+		defer close(items)
+
+		after := ""
+		count := 0
+
+		for {
+			// Check context cancellation
+			if err := ctx.Err(); err != nil {
+				errs <- err
+				return
+			}
+
+			// Fetch the next page
+			pageItems, nextAfter, err := fetchPage(ctx, after)
+			if err != nil {
+				errs <- fmt.Errorf("pagination.PaginateStream: fetch page failed (after=%q): %w", after, err)
+				return
+			}
+
+			// Emit items, stopping as soon as the consumer goes away or the limit is reached
+			for _, item := range pageItems {
+				select {
+				case items <- item:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+
+				count++
+				if opts.Limit > 0 && count >= opts.Limit {
+					return
+				}
+			}
+
+			// Stop if there are no more pages
+			if nextAfter == "" {
+				return
+			}
+
+			// Stop if we got an empty page (prevents infinite loops with misbehaving APIs)
+			if opts.StopOnEmpty && len(pageItems) == 0 {
+				return
+			}
+
+			// Update the after token for the next request
+			after = nextAfter
+		}
+	}()
+
+	return items, errs
+}
+
+// tailStream streams the pages of fetchPage like PaginateStream, then keeps the stream open and
+// polls every interval for items added since, until ctx is cancelled ("live tail" mode).
+//
+// Each poll calls fetchNewer with the key of the newest item seen so far, the before cursor for
+// listings that support one. fetchNewer returns items newest first, along with a non-empty token
+// while more new items remain; the poll then continues from the newest item of that page. Items
+// whose key was already seen are skipped, so fetchNewer may also return the latest items
+// regardless of the cursor. New items are emitted oldest first.
+//
+// The error channel receives the context's error once ctx is cancelled, or the first fetch error,
+// after which both channels are closed.
+func tailStream[T any](
+	ctx context.Context,
+	fetchPage, fetchNewer FetchPageFunc[T],
+	key func(T) string,
+	opts PaginationOptions,
+	interval time.Duration,
+) (<-chan T, <-chan error) {
+	items := make(chan T, max(opts.BufferSize, 0))
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(items)
+
+		emit := func(item T) bool {
+			select {
+			case items <- item:
+				return true
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return false
+			}
+		}
+
+		// Stream the existing items first. The backlog stream stops on its own once ctx is
+		// cancelled, so it is safe to stop reading from it early.
+		opts.BufferSize = 0
+		backlog, backlogErrs := PaginateStream(ctx, fetchPage, opts)
+		newest := ""
+		seen := make(map[string]bool)
+		for item := range backlog {
+			k := key(item)
+			if newest == "" {
+				newest = k
+			}
+			seen[k] = true
+			if !emit(item) {
+				return
+			}
+		}
+		if err := <-backlogErrs; err != nil {
+			errs <- err
+			return
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			case <-ticker.C:
+			}
+
+			// Collect every page of new items, oldest first, before emitting any of them
+			var fresh []T
+			polled := make(map[string]bool)
+			cursor := newest
+			for {
+				page, next, err := fetchNewer(ctx, cursor)
+				if err != nil {
+					if ctxErr := ctx.Err(); ctxErr != nil {
+						errs <- ctxErr
+					} else {
+						errs <- fmt.Errorf("pagination.tailStream: poll failed (before=%q): %w", cursor, err)
+					}
+					return
+				}
+				for i := len(page) - 1; i >= 0; i-- {
+					k := key(page[i])
+					if !seen[k] && !polled[k] {
+						fresh = append(fresh, page[i])
+					}
+					polled[k] = true
+				}
+				if len(page) == 0 || next == "" {
+					break
+				}
+				cursor = key(page[0])
+			}
+
+			for _, item := range fresh {
+				if !emit(item) {
+					return
+				}
+				newest = key(item)
+			}
+
+			// Items drop out of the latest page as newer ones arrive, so only the keys of this
+			// poll are needed to recognise items on the next one
+			if len(polled) > 0 {
+				seen = polled
+			}
+		}
+	}()
+
+	return items, errs
+}
+
+// contextError returns the context error that ended a failed page fetch, or nil if the fetch
+// failed for another reason. A request that gave up on a retry because it could not complete
+// before the deadline counts as having hit the deadline, even though the context hasn't expired yet.
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	if errors.Is(err, errRetryPastDeadline) {
+		return context.DeadlineExceeded
+	}
+	return nil
+}
+
+// fullnamer is implemented by Reddit things that have a fullname, such as Post and Comment
+type fullnamer interface {
+	Fullname() string
+}
+
+// deduplicatePages wraps fetchPage so that each page only contains items whose fullname was not
+// returned by an earlier call. Items without a fullname are always kept.
+func deduplicatePages[T any](fetchPage FetchPageFunc[T]) FetchPageFunc[T] {
+	seen := make(map[string]struct{})
+	return func(ctx context.Context, after string) ([]T, string, error) {
+		items, nextAfter, err := fetchPage(ctx, after)
+		if err != nil {
+			return items, nextAfter, err
+		}
+
+		unique := make([]T, 0, len(items))
+		for _, item := range items {
+			if named, ok := any(item).(fullnamer); ok {
+				fullname := named.Fullname()
+				if _, dup := seen[fullname]; dup {
+					continue
+				}
+				seen[fullname] = struct{}{}
+			}
+			unique = append(unique, item)
+		}
+		return unique, nextAfter, nil
+	}
+}
+
 // PaginateSingle fetches a single page of items.
 // This is useful when you only want one page of results, not all available pages.
 //
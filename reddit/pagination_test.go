@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"runtime"
+	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -171,6 +174,28 @@ var _ = Describe("Pagination", func() {
 				Expect(calls).To(Equal([]string{"", "after_page_1"}))
 			})
 
+			It("should return partial results on error when PartialResultsOnError is set", func() {
+				fetchErr := errors.New("fetch error")
+				fetchPage := func(ctx context.Context, after string) ([]string, string, error) {
+					calls = append(calls, after)
+
+					if len(calls) == 1 {
+						return []string{"item1", "item2"}, "after_page_1", nil
+					}
+
+					return nil, "", fetchErr
+				}
+
+				opts := DefaultPaginationOptions()
+				opts.PartialResultsOnError = true
+
+				result, err := PaginateAll[string](ctx, fetchPage, opts)
+
+				Expect(errors.Is(err, fetchErr)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(`after="after_page_1"`))
+				Expect(result).To(Equal([]string{"item1", "item2"}))
+			})
+
 			It("should handle context cancellation", func() {
 				cancelCtx, cancel := context.WithCancel(ctx)
 
@@ -195,6 +220,56 @@ var _ = Describe("Pagination", func() {
 				Expect(len(calls)).To(Equal(1)) // Only one call should have been made
 			})
 
+			It("should return partial results on cancellation when PartialResultsOnCancel is set", func() {
+				cancelCtx, cancel := context.WithCancel(ctx)
+
+				fetchPage := func(ctx context.Context, after string) ([]string, string, error) {
+					calls = append(calls, after)
+
+					if len(calls) == 1 {
+						return []string{"item1", "item2"}, "after_page_1", nil
+					}
+
+					// Simulate the context expiring while the second page is in flight
+					cancel()
+					return nil, "", fmt.Errorf("request aborted: %w", ctx.Err())
+				}
+
+				opts := DefaultPaginationOptions()
+				opts.PartialResultsOnCancel = true
+
+				result, err := PaginateAll[string](cancelCtx, fetchPage, opts)
+
+				Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("stopped after 2 items"))
+				Expect(result).To(Equal([]string{"item1", "item2"}))
+			})
+
+			It("should skip items already seen when Deduplicate is set", func() {
+				pages := [][]Post{
+					{{ID: "post1"}, {ID: "post2"}, {ID: "post1"}},
+					{{ID: "post2"}, {ID: "post3"}},
+				}
+				fetchPage := func(ctx context.Context, after string) ([]Post, string, error) {
+					if after == "" {
+						return pages[0], "t3_post1", nil
+					}
+					return pages[1], "", nil
+				}
+
+				opts := DefaultPaginationOptions()
+				opts.Deduplicate = true
+
+				result, err := PaginateAll(ctx, fetchPage, opts)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(HaveLen(3))
+				Expect(result[0].ID).To(Equal("post1"))
+				Expect(result[1].ID).To(Equal("post2"))
+				Expect(result[2].ID).To(Equal("post3"))
+				Expect(pages[0]).To(HaveLen(3)) // The fetched pages are left untouched
+			})
+
 			It("should return error when fetchPage is nil", func() {
 				opts := DefaultPaginationOptions()
 
@@ -294,6 +369,195 @@ var _ = Describe("Pagination", func() {
 		})
 	})
 
+	Describe("PaginateStream", func() {
+		var pages map[string]struct {
+			items []string
+			next  string
+		}
+
+		BeforeEach(func() {
+			pages = map[string]struct {
+				items []string
+				next  string
+			}{
+				"":       {items: []string{"item1", "item2"}, next: "page_2"},
+				"page_2": {items: []string{"item3"}, next: ""},
+			}
+		})
+
+		fetchPage := func(ctx context.Context, after string) ([]string, string, error) {
+			page := pages[after]
+			return page.items, page.next, nil
+		}
+
+		It("should emit items from every page in order", func() {
+			items, errs := PaginateStream[string](ctx, fetchPage, DefaultPaginationOptions())
+
+			var received []string
+			for item := range items {
+				received = append(received, item)
+			}
+
+			Expect(received).To(Equal([]string{"item1", "item2", "item3"}))
+			Expect(<-errs).To(BeNil())
+		})
+
+		It("should stop at the limit", func() {
+			opts := DefaultPaginationOptions()
+			opts.Limit = 2
+
+			items, errs := PaginateStream[string](ctx, fetchPage, opts)
+
+			var received []string
+			for item := range items {
+				received = append(received, item)
+			}
+
+			Expect(received).To(Equal([]string{"item1", "item2"}))
+			Expect(<-errs).To(BeNil())
+		})
+
+		It("should report fetch errors on the error channel", func() {
+			failing := func(ctx context.Context, after string) ([]string, string, error) {
+				if after == "" {
+					return []string{"item1"}, "page_2", nil
+				}
+				return nil, "", errors.New("boom")
+			}
+
+			items, errs := PaginateStream[string](ctx, failing, DefaultPaginationOptions())
+
+			var received []string
+			for item := range items {
+				received = append(received, item)
+			}
+
+			Expect(received).To(Equal([]string{"item1"}))
+			err := <-errs
+			Expect(err).To(MatchError(ContainSubstring("boom")))
+			Expect(err.Error()).To(ContainSubstring(`after="page_2"`))
+		})
+
+		It("should close the channels when the context is cancelled", func() {
+			cancelCtx, cancel := context.WithCancel(ctx)
+			items, errs := PaginateStream[string](cancelCtx, fetchPage, DefaultPaginationOptions())
+
+			Expect(<-items).To(Equal("item1"))
+			cancel()
+
+			Eventually(items).Should(BeClosed())
+			Expect(<-errs).To(Equal(context.Canceled))
+			Eventually(errs).Should(BeClosed())
+		})
+
+		It("should return error when fetchPage is nil", func() {
+			items, errs := PaginateStream[string](ctx, nil, DefaultPaginationOptions())
+
+			Eventually(items).Should(BeClosed())
+			Expect(<-errs).To(MatchError(ContainSubstring("fetchPage function is required")))
+		})
+	})
+
+	Describe("tailStream", func() {
+		var (
+			mu      sync.Mutex
+			latest  []string // newest first, as a listing returns them
+			cursors []string
+		)
+
+		BeforeEach(func() {
+			latest = []string{"item2", "item1"}
+			cursors = nil
+		})
+
+		fetchBacklog := func(ctx context.Context, after string) ([]string, string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string(nil), latest...), "", nil
+		}
+
+		// fetchNewer ignores the cursor and returns the latest items, leaving tailStream to skip
+		// the ones already emitted
+		fetchNewer := func(ctx context.Context, before string) ([]string, string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			cursors = append(cursors, before)
+			return append([]string(nil), latest...), "", nil
+		}
+
+		publish := func(items ...string) {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, item := range items {
+				latest = append([]string{item}, latest...)
+			}
+		}
+
+		key := func(item string) string { return item }
+
+		It("should emit new items across polls, oldest first", func() {
+			cancelCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			items, errs := tailStream(cancelCtx, fetchBacklog, fetchNewer, key, DefaultPaginationOptions(), 5*time.Millisecond)
+
+			Expect(<-items).To(Equal("item2"))
+			Expect(<-items).To(Equal("item1"))
+
+			publish("item3")
+			Eventually(items).Should(Receive(Equal("item3")))
+
+			publish("item4", "item5")
+			Eventually(items).Should(Receive(Equal("item4")))
+			Eventually(items).Should(Receive(Equal("item5")))
+			Consistently(items, 30*time.Millisecond).ShouldNot(Receive())
+
+			cancel()
+			Eventually(items).Should(BeClosed())
+			Expect(<-errs).To(Equal(context.Canceled))
+
+			mu.Lock()
+			defer mu.Unlock()
+			Expect(cursors[0]).To(Equal("item2"))
+			Expect(cursors[len(cursors)-1]).To(Equal("item5"))
+		})
+
+		It("should exit its goroutines when the context is cancelled", func() {
+			before := runtime.NumGoroutine()
+			cancelCtx, cancel := context.WithCancel(ctx)
+
+			opts := DefaultPaginationOptions()
+			opts.BufferSize = 4
+			items, errs := tailStream(cancelCtx, fetchBacklog, fetchNewer, key, opts, time.Millisecond)
+			Expect(cap(items)).To(Equal(4))
+
+			// Stop reading mid-stream so the producer is left blocked or waiting on the ticker
+			Eventually(items).Should(Receive(Equal("item2")))
+			cancel()
+
+			for range items {
+			}
+			Expect(<-errs).To(Equal(context.Canceled))
+			Eventually(runtime.NumGoroutine).Should(BeNumerically("<=", before))
+		})
+
+		It("should report poll errors and close the channels", func() {
+			failing := func(ctx context.Context, before string) ([]string, string, error) {
+				return nil, "", errors.New("boom")
+			}
+
+			items, errs := tailStream(ctx, fetchBacklog, failing, key, DefaultPaginationOptions(), time.Millisecond)
+
+			var received []string
+			for item := range items {
+				received = append(received, item)
+			}
+
+			Expect(received).To(Equal([]string{"item2", "item1"}))
+			Expect(<-errs).To(MatchError(ContainSubstring(`poll failed (before="item2"): boom`)))
+		})
+	})
+
 	Describe("PaginateSingle", func() {
 		Context("fetching a single page", func() {
 			It("should return a single page of results", func() {
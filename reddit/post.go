@@ -1,23 +1,49 @@
 package reddit
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 // Post represents a Reddit post with relevant fields.
 type Post struct {
-	Title        string        `json:"title"`
-	SelfText     string        `json:"selftext"`
-	URL          string        `json:"url"`
-	Created      int64         `json:"created_utc"`
-	Subreddit    string        `json:"subreddit"`
-	ID           string        `json:"id"`
-	RedditScore  int           `json:"score"` // Reddit's upvotes minus downvotes
-	ContentScore int           `json:"-"`     // Our custom content-based score
-	CommentCount int           `json:"num_comments"`
-	Comments     []Comment     `json:"comments,omitempty"`
-	client       commentGetter // interface for fetching comments (should hold a pointer to the client)
+	Title         string          `json:"title"`
+	SelfText      string          `json:"selftext"`
+	URL           string          `json:"url"`
+	Created       int64           `json:"created_utc"`
+	Subreddit     string          `json:"subreddit"`
+	ID            string          `json:"id"`
+	RedditScore   int             `json:"score"` // Reddit's upvotes minus downvotes
+	ContentScore  int             `json:"-"`     // Our custom content-based score
+	CommentCount  int             `json:"num_comments"`
+	Author        string          `json:"author"`
+	Permalink     string          `json:"permalink"` // Path of the post's comments page, relative to reddit.com
+	IsSelf        bool            `json:"is_self"`
+	Over18        bool            `json:"over_18"`
+	Spoiler       bool            `json:"spoiler"`
+	Stickied      bool            `json:"stickied"`
+	LinkFlairText string          `json:"link_flair_text,omitempty"`
+	Thumbnail     string          `json:"thumbnail,omitempty"` // Image URL, or a placeholder such as "self", "default" or "nsfw"
+	UpvoteRatio   float64         `json:"upvote_ratio"`
+	Gallery       []MediaItem     `json:"gallery,omitempty"` // Images of a gallery post in display order, nil for other posts
+	Comments      []Comment       `json:"comments,omitempty"`
+	SubredditInfo *SubredditInfo  `json:"sr_detail,omitempty"` // set when fetched with WithSubredditDetail
+	RawData       json.RawMessage `json:"-"`                   // Reddit's original data object, set only by clients created with WithRawPostData
+	client        commentGetter   // interface for fetching comments (should hold a pointer to the client)
+}
+
+// MediaItem is a single image of a gallery post
+type MediaItem struct {
+	ID      string `json:"id"`   // Reddit's media ID, the key in the post's media_metadata
+	Type    string `json:"type"` // MIME type, such as "image/jpg" or "image/gif"
+	URL     string `json:"url"`  // Full-size source image, or the GIF of an animated image
+	Width   int    `json:"width"`
+	Height  int    `json:"height"`
+	Caption string `json:"caption,omitempty"`
 }
 
 // commentGetter interface for fetching comments (private interface)
@@ -40,6 +66,16 @@ func (p Post) String() string {
 			"    RedditScore: %d\n"+
 			"    ContentScore: %d\n"+
 			"    CommentCount: %d\n"+
+			"    Author: %q\n"+
+			"    Permalink: %q\n"+
+			"    IsSelf: %t\n"+
+			"    Over18: %t\n"+
+			"    Spoiler: %t\n"+
+			"    Stickied: %t\n"+
+			"    LinkFlairText: %q\n"+
+			"    Thumbnail: %q\n"+
+			"    UpvoteRatio: %.2f\n"+
+			"    Gallery: %d\n"+
 			"    Comments: %d\n"+
 			"}",
 		p.Title,
@@ -51,6 +87,16 @@ func (p Post) String() string {
 		p.RedditScore,
 		p.ContentScore,
 		p.CommentCount,
+		p.Author,
+		p.Permalink,
+		p.IsSelf,
+		p.Over18,
+		p.Spoiler,
+		p.Stickied,
+		p.LinkFlairText,
+		p.Thumbnail,
+		p.UpvoteRatio,
+		len(p.Gallery),
 		len(p.Comments),
 	)
 }
@@ -67,10 +113,45 @@ func parsePost(item any, client commentGetter) (Post, error) {
 		return Post{}, fmt.Errorf("post.parsePost: invalid post data format")
 	}
 
+	post, err := postFromData(data, nil, client)
+	if err != nil {
+		return Post{}, fmt.Errorf("post.parsePost: %w", err)
+	}
+	return post, nil
+}
+
+// decodePost is the ListingItemDecoder for posts fetched by the client. The data object is decoded
+// the way the client decodes responses, so WithJSONNumberMode applies to it.
+func (c *Client) decodePost(_ string, data json.RawMessage) (Post, error) {
+	var fields map[string]any
+	if err := c.decodeJSON(bytes.NewReader(data), &fields); err != nil {
+		return Post{}, fmt.Errorf("post.decodePost: invalid post data format: %w", err)
+	}
+
+	post, err := postFromData(fields, data, c)
+	if err != nil {
+		return Post{}, fmt.Errorf("post.decodePost: %w", err)
+	}
+	return post, nil
+}
+
+// postFromData builds a post from its data object, keeping raw as RawData for clients created with
+// WithRawPostData. When raw is nil the data object is encoded again instead.
+func postFromData(data map[string]any, raw json.RawMessage, client commentGetter) (Post, error) {
 	// Use type-safe field extractors
 	post, err := parsePostData(data)
 	if err != nil {
-		return Post{}, fmt.Errorf("post.parsePost: %w", err)
+		return Post{}, err
+	}
+
+	// Keep the original data object for callers that need fields Post does not map
+	if c, ok := client.(*Client); ok && c.rawPostData {
+		if raw == nil {
+			if raw, err = json.Marshal(data); err != nil {
+				return Post{}, fmt.Errorf("encoding raw data failed: %w", err)
+			}
+		}
+		post.RawData = raw
 	}
 
 	// Set the client for comment fetching
@@ -114,20 +195,369 @@ func (p *Post) GetComments(ctx context.Context, opts ...CommentOption) ([]Commen
 	if err != nil {
 		return nil, fmt.Errorf("post.GetComments: fetching comments failed: %w", err)
 	}
-	return parseComments(data)
+	return parseComments(data, p.client)
+}
+
+// GetCommentThread fetches the thread focused on one of the post's comments, as shown by its
+// permalink, from /r/{subreddit}/comments/{postID}/_/{commentID}. The ID may be given with or
+// without the t1_ prefix. It returns the focused comment with its replies nested in Replies, or,
+// when WithCommentContext asks for parent comments, the highest of those parents with the chain
+// down to the focused comment nested beneath it. Options such as WithCommentSort and
+// WithCommentDepth apply as for GetComments.
+func (p *Post) GetCommentThread(ctx context.Context, commentID string, opts ...CommentOption) ([]Comment, error) {
+	client, ok := p.client.(actionRequester)
+	if !ok {
+		return nil, fmt.Errorf("post.GetCommentThread: post has no associated client")
+	}
+
+	comments, err := getCommentThread(ctx, client, p.Subreddit, p.ID, commentID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("post.GetCommentThread: %w", err)
+	}
+	return comments, nil
+}
+
+// getCommentThread fetches and parses the thread focused on a comment, for GetCommentThread and
+// Comment.GetReplies. The comment ID may have the t1_ prefix.
+func getCommentThread(ctx context.Context, client actionRequester, subreddit, postID, commentID string, opts ...CommentOption) ([]Comment, error) {
+	commentID = strings.TrimPrefix(commentID, "t1_")
+	if commentID == "" {
+		return nil, fmt.Errorf("comment ID is empty")
+	}
+
+	params := make(map[string]string)
+	for _, opt := range opts {
+		opt(params)
+	}
+	if sort, ok := params["sort"]; ok {
+		if err := CommentSort(sort).Valid(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c, ok := client.(*Client); ok {
+		c.setListingParams(params)
+	}
+	base := fmt.Sprintf("/r/%s/comments/%s/_/%s", subreddit, postID, commentID)
+	var data []any
+	if err := client.requestJSON(ctx, "GET", BuildEndpoint(base, params), nil, &data); err != nil {
+		return nil, err
+	}
+
+	return parseCommentThread(data, client)
+}
+
+// Limits on the "more" expansion done by GetCommentTree. Reddit accepts up to 100 comment IDs per
+// /api/morechildren request; the request cap keeps a huge thread from costing many requests.
+const (
+	moreChildrenBatchSize    = 100
+	maxCommentTreeExpansions = 3
+)
+
+// GetCommentTree fetches the post's comments as a tree, with replies nested in Replies down to
+// maxDepth levels (top-level comments are level 1; 0 or less uses Reddit's default depth). Top-level
+// comments Reddit leaves out of the first response, behind a "more" placeholder, are loaded once
+// through /api/morechildren, in at most three requests of up to 100 comments each; placeholders
+// in the expanded comments and deeper in the tree are not followed. Every request goes through
+// the client's rate limiter.
+func (p *Post) GetCommentTree(ctx context.Context, maxDepth int) ([]Comment, error) {
+	client, ok := p.client.(actionRequester)
+	if !ok {
+		return nil, fmt.Errorf("post.GetCommentTree: post has no associated client")
+	}
+
+	data, err := p.client.getComments(ctx, p.Subreddit, p.ID, WithCommentDepth(maxDepth))
+	if err != nil {
+		return nil, fmt.Errorf("post.GetCommentTree: fetching comments failed: %w", err)
+	}
+	tree, err := parseCommentThread(data, client)
+	if err != nil {
+		return nil, fmt.Errorf("post.GetCommentTree: %w", err)
+	}
+
+	ids := moreChildrenIDs(data)
+	for batch := 0; len(ids) > 0 && batch < maxCommentTreeExpansions; batch++ {
+		n := min(len(ids), moreChildrenBatchSize)
+		expanded, err := p.moreChildren(ctx, client, ids[:n], maxDepth)
+		if err != nil {
+			return tree, fmt.Errorf("post.GetCommentTree: expanding comments failed: %w", err)
+		}
+		tree = append(tree, BuildCommentTree(expanded)...)
+		ids = ids[n:]
+	}
+
+	if maxDepth > 0 {
+		trimCommentDepth(tree, maxDepth)
+	}
+	return tree, nil
+}
+
+// moreChildren loads the comments with the given IDs, and their replies down to depth, from
+// /api/morechildren. Reddit returns them as a flat list linked by ParentID.
+func (p *Post) moreChildren(ctx context.Context, client actionRequester, ids []string, depth int) ([]Comment, error) {
+	params := map[string]string{
+		"api_type":       "json",
+		"link_id":        p.Fullname(),
+		"children":       strings.Join(ids, ","),
+		"limit_children": "false",
+	}
+	if depth > 0 {
+		params["depth"] = strconv.Itoa(depth)
+	}
+
+	var result struct {
+		JSON struct {
+			Data struct {
+				Things []any `json:"things"`
+			} `json:"data"`
+		} `json:"json"`
+	}
+	if err := client.requestJSON(ctx, "GET", BuildEndpoint("/api/morechildren", params), nil, &result); err != nil {
+		return nil, err
+	}
+
+	// The things form a listing of their own, so they parse like a comments response
+	listing := map[string]any{"data": map[string]any{"children": result.JSON.Data.Things}}
+	return parseCommentListing(listing, client, nowUnix()), nil
+}
+
+// GetDuplicates fetches other submissions of the same link, such as crossposts to other
+// subreddits, from /duplicates/{id}. The post itself is not included.
+func (p *Post) GetDuplicates(ctx context.Context) ([]Post, error) {
+	client, ok := p.client.(actionRequester)
+	if !ok {
+		return nil, fmt.Errorf("post.GetDuplicates: post has no associated client")
+	}
+
+	var data []any
+	if err := client.requestJSON(ctx, "GET", "/duplicates/"+p.ID, nil, &data); err != nil {
+		return nil, fmt.Errorf("post.GetDuplicates: %w", err)
+	}
+
+	// The response pairs a listing holding the original post with a listing of its duplicates
+	if len(data) < 2 {
+		return nil, fmt.Errorf("post.GetDuplicates: unexpected response format")
+	}
+	duplicates, ok := data[1].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("post.GetDuplicates: unexpected response format")
+	}
+
+	posts, _, err := parsePosts(duplicates, p.client)
+	if err != nil {
+		return nil, fmt.Errorf("post.GetDuplicates: %w", err)
+	}
+	return posts, nil
+}
+
+// Refresh re-fetches the post from /by_id/{fullname}.json and updates the fields that change over
+// its lifetime: RedditScore, CommentCount, UpvoteRatio, SelfText, LinkFlairText, Thumbnail and
+// the Over18, Spoiler and Stickied flags, plus RawData for clients created with WithRawPostData.
+// Other fields, including ContentScore and Comments, are left as they are. It returns an error
+// wrapping ErrNotFound if the post no longer exists or was deleted by its author, leaving the
+// post unchanged.
+func (p *Post) Refresh(ctx context.Context) error {
+	client, ok := p.client.(actionRequester)
+	if !ok {
+		return fmt.Errorf("post.Refresh: post has no associated client")
+	}
+
+	params := make(map[string]string)
+	if c, ok := client.(*Client); ok {
+		c.setListingParams(params)
+	}
+	var data map[string]any
+	if err := client.requestJSON(ctx, "GET", BuildEndpoint("/by_id/"+p.Fullname()+".json", params), nil, &data); err != nil {
+		return fmt.Errorf("post.Refresh: %w", err)
+	}
+
+	posts, _, err := parsePosts(data, p.client)
+	if err != nil {
+		return fmt.Errorf("post.Refresh: %w", err)
+	}
+	if len(posts) == 0 || posts[0].ID != p.ID || postDeleted(data) {
+		return fmt.Errorf("post.Refresh: post %s: %w", p.Fullname(), ErrNotFound)
+	}
+
+	fresh := posts[0]
+	p.RedditScore = fresh.RedditScore
+	p.CommentCount = fresh.CommentCount
+	p.UpvoteRatio = fresh.UpvoteRatio
+	p.SelfText = fresh.SelfText
+	p.LinkFlairText = fresh.LinkFlairText
+	p.Thumbnail = fresh.Thumbnail
+	p.Over18 = fresh.Over18
+	p.Spoiler = fresh.Spoiler
+	p.Stickied = fresh.Stickied
+	if fresh.RawData != nil {
+		p.RawData = fresh.RawData
+	}
+	return nil
+}
+
+// postDeleted reports whether the first post of a listing was deleted by its author, which Reddit
+// still returns with its author and text replaced by "[deleted]"
+func postDeleted(data map[string]any) bool {
+	listing, _ := data["data"].(map[string]any)
+	children, _ := listing["children"].([]any)
+	if len(children) == 0 {
+		return false
+	}
+	item, _ := children[0].(map[string]any)
+	post, _ := item["data"].(map[string]any)
+	return getStringField(post, "removed_by_category") == "deleted"
+}
+
+// Vote casts the authenticated user's vote on the post: 1 to upvote, -1 to downvote and 0 to clear
+// an existing vote. It requires user context authentication (see NewAuthWithRefreshToken) with the
+// vote scope, and returns an error wrapping ErrInvalidVote for any other direction.
+func (p *Post) Vote(ctx context.Context, dir int) error {
+	client, ok := p.client.(actionRequester)
+	if !ok {
+		return fmt.Errorf("post.Vote: post has no associated client")
+	}
+	if err := vote(ctx, client, p.Fullname(), dir); err != nil {
+		return fmt.Errorf("post.Vote: %w", err)
+	}
+	return nil
+}
+
+// Save saves the post to the authenticated user's saved items. It requires user context
+// authentication with the save scope; tokens without it get an error wrapping ErrForbidden.
+func (p *Post) Save(ctx context.Context) error {
+	return p.action(ctx, "post.Save", "/api/save")
+}
+
+// Unsave removes the post from the authenticated user's saved items
+func (p *Post) Unsave(ctx context.Context) error {
+	return p.action(ctx, "post.Unsave", "/api/unsave")
+}
+
+// Hide hides the post from the authenticated user's listings. It requires user context
+// authentication with the report scope; tokens without it get an error wrapping ErrForbidden.
+func (p *Post) Hide(ctx context.Context) error {
+	return p.action(ctx, "post.Hide", "/api/hide")
+}
+
+// Unhide shows a previously hidden post in the authenticated user's listings again
+func (p *Post) Unhide(ctx context.Context) error {
+	return p.action(ctx, "post.Unhide", "/api/unhide")
+}
+
+// action performs a write action that takes only the post's fullname
+func (p *Post) action(ctx context.Context, name, endpoint string) error {
+	client, ok := p.client.(actionRequester)
+	if !ok {
+		return fmt.Errorf("%s: post has no associated client", name)
+	}
+	if err := thingAction(ctx, client, endpoint, p.Fullname()); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
 }
 
 // GetCommentsAfter fetches comments that come after the specified comment.
-// This method will automatically fetch multiple pages as needed up to the specified limit.
-// Set limit to 0 to fetch all available comments (use with caution).
-func (p *Post) GetCommentsAfter(ctx context.Context, after *Comment, limit int) ([]Comment, error) {
+// This method will automatically fetch multiple pages as needed. Options such as WithCommentSort
+// and WithCommentDepth apply to every page; a limit set with WithCommentLimit caps the number of
+// comments returned, and without one all available comments are fetched (use with caution).
+func (p *Post) GetCommentsAfter(ctx context.Context, after *Comment, opts ...CommentOption) ([]Comment, error) {
 	if p.client == nil {
 		return nil, fmt.Errorf("post.GetCommentsAfter: post has no associated client")
 	}
 
-	// Create fetch function for comments pagination
-	fetchPage := func(ctx context.Context, afterToken string) ([]Comment, string, error) {
-		opts := []CommentOption{WithCommentLimit(100)}
+	params := make(map[string]string)
+	for _, opt := range opts {
+		opt(params)
+	}
+	limit, _ := strconv.Atoi(params["limit"])
+
+	fetchPage := p.commentsPageFetcher(opts...)
+
+	// Extract after token function
+	extractAfter := func(comment Comment) string {
+		return comment.Fullname()
+	}
+
+	// Configure pagination options, following the client's partial results policies when it has them
+	paginationOpts := PaginationOptions{
+		Limit:       limit,
+		PageSize:    100,
+		StopOnEmpty: true,
+	}
+	if client, ok := p.client.(*Client); ok {
+		paginationOpts.PartialResultsOnCancel = client.partialOnCancel
+		paginationOpts.PartialResultsOnError = client.partialOnError
+	}
+
+	// Use PaginateAfter if we have an initial comment, otherwise PaginateAll
+	if after != nil {
+		return PaginateAfter(ctx, fetchPage, extractAfter, after, paginationOpts)
+	}
+
+	return PaginateAll(ctx, fetchPage, paginationOpts)
+}
+
+// StreamComments fetches the post's comments page by page, like GetCommentsAfter, and emits them
+// on the returned channel as each page arrives, so large threads can be processed incrementally.
+// Options apply to every page; a limit set with WithCommentLimit caps the number of comments
+// streamed, and without one all comments are streamed.
+//
+// Both channels are closed when streaming ends. The error channel receives at most one error:
+// the context's error if ctx is cancelled, or the first page fetch error.
+//
+// stream.BufferSize sets the capacity of the comment channel. stream.PollInterval keeps the stream
+// open once the existing comments have been streamed, polling the newest top-level comments and
+// emitting the ones not seen before, oldest first, until ctx is cancelled.
+func (p *Post) StreamComments(ctx context.Context, stream StreamOptions, opts ...CommentOption) (<-chan Comment, <-chan error) {
+	if p.client == nil {
+		comments := make(chan Comment)
+		errs := make(chan error, 1)
+		errs <- fmt.Errorf("post.StreamComments: post has no associated client")
+		close(comments)
+		close(errs)
+		return comments, errs
+	}
+
+	params := make(map[string]string)
+	for _, opt := range opts {
+		opt(params)
+	}
+	limit, _ := strconv.Atoi(params["limit"])
+	paginationOpts := stream.paginationOptions(limit)
+
+	if stream.PollInterval <= 0 {
+		return PaginateStream(ctx, p.commentsPageFetcher(opts...), paginationOpts)
+	}
+	return tailStream(ctx, p.commentsPageFetcher(opts...), p.newerCommentsFetcher(opts...), Comment.Fullname, paginationOpts, stream.PollInterval)
+}
+
+// newerCommentsFetcher builds the fetch function used to poll the post for new comments. Comment
+// trees have no before cursor, so each poll fetches the newest top-level comments and leaves it
+// to the stream to skip the ones it has already seen.
+func (p *Post) newerCommentsFetcher(opts ...CommentOption) FetchPageFunc[Comment] {
+	return func(ctx context.Context, _ string) ([]Comment, string, error) {
+		pageOpts := append([]CommentOption{WithCommentLimit(100)}, opts...)
+		pageOpts = append(pageOpts, WithCommentSort(string(CommentSortNew)))
+
+		data, err := p.client.getComments(ctx, p.Subreddit, p.ID, pageOpts...)
+		if err != nil {
+			return nil, "", fmt.Errorf("fetching comments failed: %w", err)
+		}
+
+		comments, err := parseComments(data, p.client)
+		if err != nil {
+			return nil, "", fmt.Errorf("parsing comments failed: %w", err)
+		}
+		return comments, "", nil
+	}
+}
+
+// commentsPageFetcher builds the page fetch function used to paginate the post's comments.
+// Each page requests up to 100 comments unless opts set another limit, and continues after the
+// last comment of the previous page.
+func (p *Post) commentsPageFetcher(opts ...CommentOption) FetchPageFunc[Comment] {
+	return func(ctx context.Context, afterToken string) ([]Comment, string, error) {
+		pageOpts := append([]CommentOption{WithCommentLimit(100)}, opts...)
 
 		// Add after parameter if provided
 		if afterToken != "" {
@@ -135,16 +565,16 @@ func (p *Post) GetCommentsAfter(ctx context.Context, after *Comment, limit int)
 			// The afterToken should be in the format "t1_<id>"
 			if len(afterToken) > 3 && afterToken[:3] == "t1_" {
 				afterComment := &Comment{ID: afterToken[3:]} // Remove "t1_" prefix
-				opts = append(opts, WithCommentAfter(afterComment))
+				pageOpts = append(pageOpts, WithCommentAfter(afterComment))
 			}
 		}
 
-		data, err := p.client.getComments(ctx, p.Subreddit, p.ID, opts...)
+		data, err := p.client.getComments(ctx, p.Subreddit, p.ID, pageOpts...)
 		if err != nil {
 			return nil, "", fmt.Errorf("fetching comments failed: %w", err)
 		}
 
-		comments, err := parseComments(data)
+		comments, err := parseComments(data, p.client)
 		if err != nil {
 			return nil, "", fmt.Errorf("parsing comments failed: %w", err)
 		}
@@ -158,25 +588,6 @@ func (p *Post) GetCommentsAfter(ctx context.Context, after *Comment, limit int)
 
 		return comments, nextAfter, nil
 	}
-
-	// Extract after token function
-	extractAfter := func(comment Comment) string {
-		return comment.Fullname()
-	}
-
-	// Configure pagination options
-	paginationOpts := PaginationOptions{
-		Limit:       limit,
-		PageSize:    100,
-		StopOnEmpty: true,
-	}
-
-	// Use PaginateAfter if we have an initial comment, otherwise PaginateAll
-	if after != nil {
-		return PaginateAfter(ctx, fetchPage, extractAfter, after, paginationOpts)
-	}
-
-	return PaginateAll(ctx, fetchPage, paginationOpts)
 }
 
 // Fullname returns the Reddit fullname identifier for this post (t3_<id>)
@@ -3,6 +3,10 @@ package reddit_test
 import (
 	"context"
 	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/JohnPlummer/reddit-client/reddit"
 	. "github.com/onsi/ginkgo/v2"
@@ -47,6 +51,42 @@ var _ = Describe("Post", func() {
 			Expect(comments[0].Body).To(Equal("comment1"))
 		})
 
+		It("detects removed and edited comments", func() {
+			testMock.SetupComments([]any{
+				map[string]any{},
+				map[string]any{
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{"kind": "t1", "data": map[string]any{
+								"id": "c1", "author": "[deleted]", "body": "[removed]", "removed": true, "edited": false,
+							}},
+							map[string]any{"kind": "t1", "data": map[string]any{
+								"id": "c2", "author": "user2", "body": "fixed a typo", "edited": float64(1700000000),
+							}},
+							map[string]any{"kind": "t1", "data": map[string]any{
+								"id": "c3", "author": "user3", "body": "organic", "edited": false,
+							}},
+						},
+					},
+				},
+			})
+
+			comments, err := post.GetComments(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(3))
+
+			Expect(comments[0].Removed).To(BeTrue())
+			Expect(comments[0].WasEdited()).To(BeFalse())
+
+			Expect(comments[1].Removed).To(BeFalse())
+			editedAt, ok := comments[1].EditedAt()
+			Expect(ok).To(BeTrue())
+			Expect(editedAt.Unix()).To(Equal(int64(1700000000)))
+
+			Expect(comments[2].Removed).To(BeFalse())
+			Expect(comments[2].WasEdited()).To(BeFalse())
+		})
+
 		It("handles errors when fetching comments", func() {
 			expectedErr := errors.New("API error")
 			testMock.SetupError(expectedErr)
@@ -86,7 +126,7 @@ var _ = Describe("Post", func() {
 			Expect(comments[0].Body).To(Equal("comment1"))
 
 			// Get comments after the first comment
-			moreComments, err := post.GetCommentsAfter(ctx, &comments[0], 1)
+			moreComments, err := post.GetCommentsAfter(ctx, &comments[0], reddit.WithCommentLimit(1))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(moreComments).To(HaveLen(1))
 			Expect(moreComments[0].ID).To(Equal("c2"))
@@ -99,7 +139,7 @@ var _ = Describe("Post", func() {
 			expectedErr := errors.New("API error")
 			testMock.SetupError(expectedErr)
 
-			moreComments, err := post.GetCommentsAfter(ctx, &firstComment, 1)
+			moreComments, err := post.GetCommentsAfter(ctx, &firstComment, reddit.WithCommentLimit(1))
 			Expect(err).To(MatchError("pagination.PaginateAll: fetch page failed (after=\"\"): fetching comments failed: API error"))
 			Expect(errors.Is(err, expectedErr)).To(BeTrue())
 			Expect(moreComments).To(BeNil())
@@ -141,7 +181,7 @@ var _ = Describe("Post", func() {
 				}
 				testMock.SetupPageResponse("t1_c1", emptyPageData)
 
-				comments, err := post.GetCommentsAfter(ctx, nil, 5)
+				comments, err := post.GetCommentsAfter(ctx, nil, reddit.WithCommentLimit(5))
 				Expect(err).NotTo(HaveOccurred())
 				Expect(comments).To(HaveLen(1))
 				Expect(comments[0].ID).To(Equal("c1"))
@@ -174,7 +214,7 @@ var _ = Describe("Post", func() {
 				}
 				testMock.SetupComments(commentsData)
 
-				comments, err := post.GetCommentsAfter(ctx, nil, 2)
+				comments, err := post.GetCommentsAfter(ctx, nil, reddit.WithCommentLimit(2))
 				Expect(err).NotTo(HaveOccurred())
 				Expect(comments).To(HaveLen(2))
 				Expect(comments[0].ID).To(Equal("c1"))
@@ -235,7 +275,7 @@ var _ = Describe("Post", func() {
 				testMock.SetupPageResponse("t1_c2", secondPageData)
 
 				// Request exactly 3 comments
-				comments, err := post.GetCommentsAfter(ctx, nil, 3)
+				comments, err := post.GetCommentsAfter(ctx, nil, reddit.WithCommentLimit(3))
 				Expect(err).NotTo(HaveOccurred())
 				Expect(comments).To(HaveLen(3))
 				Expect(comments[0].ID).To(Equal("c1"))
@@ -275,7 +315,7 @@ var _ = Describe("Post", func() {
 				testMock.SetupPageResponse("t1_c1", emptyPageData)
 
 				// Request more than available
-				comments, err := post.GetCommentsAfter(ctx, nil, 10)
+				comments, err := post.GetCommentsAfter(ctx, nil, reddit.WithCommentLimit(10))
 				Expect(err).NotTo(HaveOccurred())
 				Expect(comments).To(HaveLen(1)) // Should only return what's available
 				Expect(comments[0].ID).To(Equal("c1"))
@@ -316,7 +356,7 @@ var _ = Describe("Post", func() {
 				testMock.SetupComments(multiCommentData)
 
 				// Request fewer than available
-				comments, err := post.GetCommentsAfter(ctx, nil, 2)
+				comments, err := post.GetCommentsAfter(ctx, nil, reddit.WithCommentLimit(2))
 				Expect(err).NotTo(HaveOccurred())
 				Expect(comments).To(HaveLen(2))
 				Expect(comments[0].ID).To(Equal("c1"))
@@ -347,7 +387,7 @@ var _ = Describe("Post", func() {
 				networkErr := errors.New("network timeout")
 				testMock.SetupPageError("t1_c1", networkErr)
 
-				comments, err := post.GetCommentsAfter(ctx, nil, 5)
+				comments, err := post.GetCommentsAfter(ctx, nil, reddit.WithCommentLimit(5))
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("network timeout"))
 				Expect(comments).To(BeNil())
@@ -385,7 +425,7 @@ var _ = Describe("Post", func() {
 				testMock.SetupPageResponse("t1_c1", emptyPageData)
 
 				// Very large limit
-				comments, err := post.GetCommentsAfter(ctx, nil, 1000000)
+				comments, err := post.GetCommentsAfter(ctx, nil, reddit.WithCommentLimit(1000000))
 				Expect(err).NotTo(HaveOccurred())
 				Expect(comments).To(HaveLen(1))
 				Expect(comments[0].ID).To(Equal("c1"))
@@ -442,7 +482,7 @@ var _ = Describe("Post", func() {
 				testMock.SetupPageResponse("t1_c2", emptyPageData)
 
 				// Zero limit should fetch all
-				comments, err := post.GetCommentsAfter(ctx, nil, 0)
+				comments, err := post.GetCommentsAfter(ctx, nil)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(comments).To(HaveLen(2))
 				Expect(comments[0].ID).To(Equal("c1"))
@@ -487,7 +527,7 @@ var _ = Describe("Post", func() {
 				}
 				testMock.SetupPageResponse("t1_c1", emptyPageData)
 
-				comments, err := post.GetCommentsAfter(ctx, nil, 5)
+				comments, err := post.GetCommentsAfter(ctx, nil, reddit.WithCommentLimit(5))
 				Expect(err).NotTo(HaveOccurred())
 				// Should include all duplicates as returned by API (client doesn't deduplicate)
 				Expect(comments).To(HaveLen(2))
@@ -500,6 +540,89 @@ var _ = Describe("Post", func() {
 		})
 	})
 
+	Describe("StreamComments", func() {
+		var (
+			post     *reddit.Post
+			testMock reddit.TestCommentGetter
+			ctx      context.Context
+		)
+
+		commentPage := func(ids ...string) []any {
+			children := make([]any, 0, len(ids))
+			for _, id := range ids {
+				children = append(children, map[string]any{
+					"data": map[string]any{"id": id, "author": "user", "body": "comment " + id},
+				})
+			}
+			return []any{
+				map[string]any{}, // First element (post data)
+				map[string]any{"data": map[string]any{"children": children}},
+			}
+		}
+
+		collect := func(comments <-chan reddit.Comment, errs <-chan error) ([]string, error) {
+			var ids []string
+			for comment := range comments {
+				ids = append(ids, comment.ID)
+			}
+			return ids, <-errs
+		}
+
+		BeforeEach(func() {
+			post, testMock = reddit.NewTestPost("123", "Test Post", "golang")
+			ctx = context.Background()
+		})
+
+		It("streams comments across pages", func() {
+			testMock.SetupComments(commentPage("c1", "c2"))
+			testMock.SetupPageResponse("t1_c2", commentPage("c3"))
+			testMock.SetupPageResponse("t1_c3", commentPage())
+
+			ids, err := collect(post.StreamComments(ctx, reddit.StreamOptions{}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(Equal([]string{"c1", "c2", "c3"}))
+			Expect(testMock.GetCallCount()).To(Equal(3))
+		})
+
+		It("stops at the comment limit", func() {
+			testMock.SetupComments(commentPage("c1", "c2"))
+			testMock.SetupPageResponse("t1_c2", commentPage("c3", "c4"))
+
+			ids, err := collect(post.StreamComments(ctx, reddit.StreamOptions{}, reddit.WithCommentLimit(3)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(Equal([]string{"c1", "c2", "c3"}))
+		})
+
+		It("reports a page error after the comments already streamed", func() {
+			testMock.SetupComments(commentPage("c1", "c2"))
+			testMock.SetupPageError("t1_c2", errors.New("server exploded"))
+
+			ids, err := collect(post.StreamComments(ctx, reddit.StreamOptions{}))
+			Expect(ids).To(Equal([]string{"c1", "c2"}))
+			Expect(err).To(MatchError(ContainSubstring("server exploded")))
+		})
+
+		It("closes both channels when the context is cancelled", func() {
+			testMock.SetupComments(commentPage("c1", "c2"))
+			cancelCtx, cancel := context.WithCancel(ctx)
+
+			comments, errs := post.StreamComments(cancelCtx, reddit.StreamOptions{})
+			Expect(<-comments).To(HaveField("ID", "c1"))
+			cancel()
+
+			for range comments {
+			}
+			Expect(<-errs).To(MatchError(context.Canceled))
+			Expect(errs).To(BeClosed())
+		})
+
+		It("fails for a post without a client", func() {
+			ids, err := collect((&reddit.Post{ID: "123"}).StreamComments(ctx, reddit.StreamOptions{}))
+			Expect(ids).To(BeEmpty())
+			Expect(err).To(MatchError(ContainSubstring("no associated client")))
+		})
+	})
+
 	Describe("Comment", func() {
 		It("returns the correct fullname format", func() {
 			comment := reddit.Comment{ID: "abc123"}
@@ -511,4 +634,743 @@ var _ = Describe("Post", func() {
 			Expect(comment.Fullname()).To(Equal("t1_"))
 		})
 	})
+
+	Describe("BuildCommentTree", func() {
+		ids := func(comments []reddit.Comment) []string {
+			var result []string
+			for _, c := range comments {
+				result = append(result, c.ID)
+			}
+			return result
+		}
+
+		It("reconstructs the thread from a flat list", func() {
+			flat := []reddit.Comment{
+				{ID: "a", ParentID: "t3_post"},
+				{ID: "a1", ParentID: "t1_a"},
+				{ID: "b", ParentID: "t3_post"},
+				{ID: "a1x", ParentID: "t1_a1"},
+				{ID: "a2", ParentID: "t1_a"},
+			}
+
+			tree := reddit.BuildCommentTree(flat)
+
+			Expect(ids(tree)).To(Equal([]string{"a", "b"}))
+			Expect(ids(tree[0].Replies)).To(Equal([]string{"a1", "a2"}))
+			Expect(ids(tree[0].Replies[0].Replies)).To(Equal([]string{"a1x"}))
+			Expect(tree[0].Replies[1].Replies).To(BeEmpty())
+			Expect(tree[1].Replies).To(BeEmpty())
+
+			// The input is left untouched
+			Expect(flat[0].Replies).To(BeNil())
+		})
+
+		It("returns orphaned replies at the top level", func() {
+			flat := []reddit.Comment{
+				{ID: "a", ParentID: "t3_post"},
+				{ID: "orphan", ParentID: "t1_missing"},
+				{ID: "orphan1", ParentID: "t1_orphan"},
+			}
+
+			tree := reddit.BuildCommentTree(flat)
+
+			Expect(ids(tree)).To(Equal([]string{"a", "orphan"}))
+			Expect(ids(tree[1].Replies)).To(Equal([]string{"orphan1"}))
+		})
+
+		It("keeps comments caught in a parent cycle", func() {
+			flat := []reddit.Comment{
+				{ID: "x", ParentID: "t1_y"},
+				{ID: "y", ParentID: "t1_x"},
+			}
+
+			tree := reddit.BuildCommentTree(flat)
+
+			Expect(ids(tree)).To(Equal([]string{"x"}))
+			Expect(ids(tree[0].Replies)).To(Equal([]string{"y"}))
+		})
+
+		It("returns an empty tree for no comments", func() {
+			Expect(reddit.BuildCommentTree(nil)).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("Voting", func() {
+	var (
+		ctx       context.Context
+		transport *reddit.TestTransport
+		client    *reddit.Client
+		forms     []url.Values
+		post      reddit.Post
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		transport = reddit.NewTestTransport()
+		forms = nil
+
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		client, err = reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithRequestInterceptor(captureForms("/api/vote", &forms)))
+		Expect(err).NotTo(HaveOccurred())
+
+		transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{
+				"children": []any{
+					map[string]any{"data": map[string]any{"id": "abc123", "title": "Post", "subreddit": "golang"}},
+				},
+			},
+		}))
+		posts, err := reddit.NewSubreddit("golang", client).GetPosts(ctx, reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(posts).To(HaveLen(1))
+		post = posts[0]
+	})
+
+	It("sends the post fullname and direction to /api/vote", func() {
+		transport.AddResponse("/api/vote", reddit.CreateJSONResponse(map[string]any{}))
+
+		Expect(post.Vote(ctx, 1)).To(Succeed())
+		Expect(forms).To(HaveLen(1))
+		Expect(forms[0].Get("id")).To(Equal("t3_abc123"))
+		Expect(forms[0].Get("dir")).To(Equal("1"))
+	})
+
+	It("sends the comment fullname and direction to /api/vote", func() {
+		transport.AddResponse("/r/golang/comments/abc123", reddit.CreateJSONResponse([]any{
+			map[string]any{},
+			map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "def456", "body": "Comment"}},
+					},
+				},
+			},
+		}))
+		comments, err := post.GetComments(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(comments).To(HaveLen(1))
+
+		transport.AddResponse("/api/vote", reddit.CreateJSONResponse(map[string]any{}))
+		Expect(comments[0].Vote(ctx, -1)).To(Succeed())
+		Expect(forms).To(HaveLen(1))
+		Expect(forms[0].Get("id")).To(Equal("t1_def456"))
+		Expect(forms[0].Get("dir")).To(Equal("-1"))
+	})
+
+	It("rejects an invalid direction before making a request", func() {
+		err := post.Vote(ctx, 2)
+		Expect(errors.Is(err, reddit.ErrInvalidVote)).To(BeTrue())
+		Expect(transport.GetCallHistory()).NotTo(ContainElement(HavePrefix("/api/vote")))
+	})
+
+	It("surfaces a 403 as ErrForbidden", func() {
+		transport.AddResponse("/api/vote", &http.Response{StatusCode: http.StatusForbidden, Body: http.NoBody})
+
+		err := post.Vote(ctx, 0)
+		Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+	})
+
+	It("surfaces errors Reddit reports in a 200 response body", func() {
+		transport.AddResponse("/api/vote", reddit.CreateJSONResponse(map[string]any{
+			"json": map[string]any{
+				"errors": []any{[]any{"RATELIMIT", "you are doing that too much. try again in 1 minute.", "ratelimit"}},
+			},
+		}))
+
+		err := post.Vote(ctx, 1)
+		Expect(errors.Is(err, reddit.ErrRateLimited)).To(BeTrue())
+
+		var apiErr *reddit.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(http.StatusOK))
+		Expect(apiErr.Reason).To(Equal("RATELIMIT"))
+		Expect(apiErr.Message).To(ContainSubstring("try again in 1 minute"))
+	})
+
+	It("returns an error for posts and comments without a client", func() {
+		Expect((&reddit.Post{ID: "abc123"}).Vote(ctx, 1)).To(MatchError(ContainSubstring("no associated client")))
+		Expect((&reddit.Comment{ID: "def456"}).Vote(ctx, 1)).To(MatchError(ContainSubstring("no associated client")))
+	})
+})
+
+// captureForms returns a request interceptor that records the form bodies sent to path
+func captureForms(path string, forms *[]url.Values) reddit.RequestInterceptor {
+	return func(req *http.Request) error {
+		if req.URL.Path != path || req.GetBody == nil {
+			return nil
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		form, err := url.ParseQuery(string(data))
+		if err != nil {
+			return err
+		}
+		*forms = append(*forms, form)
+		return nil
+	}
+}
+
+var _ = Describe("Saving and hiding", func() {
+	var (
+		ctx       context.Context
+		transport *reddit.TestTransport
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		transport = reddit.NewTestTransport()
+	})
+
+	// fetchPost returns a post fetched through a client that records the forms sent to endpoint.
+	// Its comment listing is also queued, since each test transport response is read once.
+	fetchPost := func(endpoint string, forms *[]url.Values) reddit.Post {
+		transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{
+				"children": []any{
+					map[string]any{"data": map[string]any{"id": "abc123", "title": "Post", "subreddit": "golang"}},
+				},
+			},
+		}))
+		transport.AddResponse("/r/golang/comments/abc123", reddit.CreateJSONResponse([]any{
+			map[string]any{},
+			map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "def456", "body": "Comment"}},
+					},
+				},
+			},
+		}))
+
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err := reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithRequestInterceptor(captureForms(endpoint, forms)))
+		Expect(err).NotTo(HaveOccurred())
+
+		posts, err := reddit.NewSubreddit("golang", client).GetPosts(ctx, reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(posts).To(HaveLen(1))
+		transport.AddResponse(endpoint, reddit.CreateJSONResponse(map[string]any{}))
+		return posts[0]
+	}
+
+	It("saves and unsaves posts", func() {
+		var saveForms, unsaveForms []url.Values
+		post := fetchPost("/api/save", &saveForms)
+		Expect(post.Save(ctx)).To(Succeed())
+		Expect(saveForms).To(HaveLen(1))
+		Expect(saveForms[0].Get("id")).To(Equal("t3_abc123"))
+
+		post = fetchPost("/api/unsave", &unsaveForms)
+		Expect(post.Unsave(ctx)).To(Succeed())
+		Expect(unsaveForms).To(HaveLen(1))
+		Expect(unsaveForms[0].Get("id")).To(Equal("t3_abc123"))
+	})
+
+	It("hides and unhides posts", func() {
+		var hideForms, unhideForms []url.Values
+		post := fetchPost("/api/hide", &hideForms)
+		Expect(post.Hide(ctx)).To(Succeed())
+		Expect(hideForms).To(HaveLen(1))
+		Expect(hideForms[0].Get("id")).To(Equal("t3_abc123"))
+
+		post = fetchPost("/api/unhide", &unhideForms)
+		Expect(post.Unhide(ctx)).To(Succeed())
+		Expect(unhideForms).To(HaveLen(1))
+		Expect(unhideForms[0].Get("id")).To(Equal("t3_abc123"))
+	})
+
+	It("saves and unsaves comments", func() {
+		var saveForms, unsaveForms []url.Values
+		post := fetchPost("/api/save", &saveForms)
+		comments, err := post.GetComments(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(comments).To(HaveLen(1))
+		Expect(comments[0].Save(ctx)).To(Succeed())
+		Expect(saveForms).To(HaveLen(1))
+		Expect(saveForms[0].Get("id")).To(Equal("t1_def456"))
+
+		post = fetchPost("/api/unsave", &unsaveForms)
+		comments, err = post.GetComments(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(comments[0].Unsave(ctx)).To(Succeed())
+		Expect(unsaveForms).To(HaveLen(1))
+		Expect(unsaveForms[0].Get("id")).To(Equal("t1_def456"))
+	})
+
+	It("surfaces a 403 as ErrForbidden", func() {
+		var forms []url.Values
+		post := fetchPost("/api/save", &forms)
+		transport.AddResponse("/api/save", &http.Response{StatusCode: http.StatusForbidden, Body: http.NoBody})
+
+		err := post.Save(ctx)
+		Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+		Expect(err.Error()).To(HavePrefix("post.Save:"))
+	})
+})
+
+var _ = Describe("Duplicates", func() {
+	var (
+		ctx       context.Context
+		transport *reddit.TestTransport
+		post      reddit.Post
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		transport = reddit.NewTestTransport()
+		transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{
+				"children": []any{
+					map[string]any{"data": map[string]any{"id": "abc123", "title": "Go 1.23 released", "subreddit": "golang"}},
+				},
+			},
+		}))
+
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err := reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: transport}))
+		Expect(err).NotTo(HaveOccurred())
+
+		posts, err := reddit.NewSubreddit("golang", client).GetPosts(ctx, reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(posts).To(HaveLen(1))
+		post = posts[0]
+	})
+
+	It("returns only the duplicate listing", func() {
+		transport.AddResponse("/duplicates/abc123", reddit.CreateJSONResponse([]any{
+			map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "abc123", "title": "Go 1.23 released", "subreddit": "golang"}},
+					},
+				},
+			},
+			map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "dup1", "title": "Go 1.23 is out", "subreddit": "programming"}},
+						map[string]any{"data": map[string]any{"id": "dup2", "title": "Go 1.23", "subreddit": "rust"}},
+					},
+				},
+			},
+		}))
+
+		duplicates, err := post.GetDuplicates(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(duplicates).To(HaveLen(2))
+		Expect(duplicates[0].ID).To(Equal("dup1"))
+		Expect(duplicates[0].Subreddit).To(Equal("programming"))
+		Expect(duplicates[1].ID).To(Equal("dup2"))
+	})
+
+	It("fails for a post without a client", func() {
+		duplicates, err := (&reddit.Post{ID: "abc123"}).GetDuplicates(ctx)
+		Expect(err).To(MatchError(ContainSubstring("no associated client")))
+		Expect(duplicates).To(BeNil())
+	})
+})
+
+var _ = Describe("Refresh", func() {
+	var (
+		ctx       context.Context
+		transport *reddit.TestTransport
+		post      reddit.Post
+	)
+
+	byID := func(children ...any) *http.Response {
+		return reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{"children": append([]any{}, children...)},
+		})
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		transport = reddit.NewTestTransport()
+		transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{
+				"children": []any{
+					map[string]any{"data": map[string]any{
+						"id": "abc123", "title": "Go 1.23 released", "subreddit": "golang",
+						"score": float64(10), "num_comments": float64(2),
+					}},
+				},
+			},
+		}))
+
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err := reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: transport}))
+		Expect(err).NotTo(HaveOccurred())
+
+		posts, err := reddit.NewSubreddit("golang", client).GetPosts(ctx, reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(posts).To(HaveLen(1))
+		post = posts[0]
+		post.ContentScore = 7
+	})
+
+	It("updates the post's score and comment count", func() {
+		transport.AddResponse("/by_id/t3_abc123.json", byID(
+			map[string]any{"data": map[string]any{
+				"id": "abc123", "title": "Go 1.23 released", "subreddit": "golang",
+				"score": float64(250), "num_comments": float64(48), "upvote_ratio": 0.97, "stickied": true,
+			}},
+		))
+
+		Expect(post.Refresh(ctx)).To(Succeed())
+
+		history := transport.GetCallHistory()
+		Expect(history[len(history)-1]).To(Equal("/by_id/t3_abc123.json?raw_json=1"))
+		Expect(post.RedditScore).To(Equal(250))
+		Expect(post.CommentCount).To(Equal(48))
+		Expect(post.UpvoteRatio).To(Equal(0.97))
+		Expect(post.Stickied).To(BeTrue())
+		Expect(post.Title).To(Equal("Go 1.23 released"))
+		Expect(post.ContentScore).To(Equal(7))
+	})
+
+	It("returns ErrNotFound for a post that no longer exists", func() {
+		transport.AddResponse("/by_id/t3_abc123.json", byID())
+
+		err := post.Refresh(ctx)
+		Expect(errors.Is(err, reddit.ErrNotFound)).To(BeTrue())
+		Expect(post.RedditScore).To(Equal(10))
+	})
+
+	It("returns ErrNotFound for a post deleted by its author", func() {
+		transport.AddResponse("/by_id/t3_abc123.json", byID(
+			map[string]any{"data": map[string]any{
+				"id": "abc123", "title": "Go 1.23 released", "author": "[deleted]", "selftext": "[deleted]",
+				"removed_by_category": "deleted", "score": float64(1),
+			}},
+		))
+
+		err := post.Refresh(ctx)
+		Expect(errors.Is(err, reddit.ErrNotFound)).To(BeTrue())
+		Expect(post.RedditScore).To(Equal(10))
+	})
+
+	It("fails for a post without a client", func() {
+		err := (&reddit.Post{ID: "abc123"}).Refresh(ctx)
+		Expect(err).To(MatchError(ContainSubstring("no associated client")))
+	})
+})
+
+var _ = Describe("GetCommentThread", func() {
+	var (
+		ctx       context.Context
+		transport *reddit.TestTransport
+		post      reddit.Post
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		transport = reddit.NewTestTransport()
+		transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{
+				"children": []any{
+					map[string]any{"data": map[string]any{"id": "abc123", "title": "Go 1.23 released", "subreddit": "golang"}},
+				},
+			},
+		}))
+
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err := reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: transport}))
+		Expect(err).NotTo(HaveOccurred())
+
+		posts, err := reddit.NewSubreddit("golang", client).GetPosts(ctx, reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(posts).To(HaveLen(1))
+		post = posts[0]
+	})
+
+	It("returns the focused subtree with its parent context", func() {
+		transport.AddResponse("/r/golang/comments/abc123/_/c2", reddit.CreateJSONResponse([]any{
+			map[string]any{"data": map[string]any{"children": []any{}}},
+			map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"kind": "t1", "data": map[string]any{
+							"id": "c1", "body": "parent", "parent_id": "t3_abc123",
+							"replies": map[string]any{"data": map[string]any{"children": []any{
+								map[string]any{"kind": "t1", "data": map[string]any{
+									"id": "c2", "body": "focused", "parent_id": "t1_c1",
+									"replies": map[string]any{"data": map[string]any{"children": []any{
+										map[string]any{"kind": "t1", "data": map[string]any{
+											"id": "c3", "body": "reply", "parent_id": "t1_c2", "replies": "",
+										}},
+										map[string]any{"kind": "more", "data": map[string]any{
+											"id": "c4", "children": []any{"c4", "c5"},
+										}},
+									}}},
+								}},
+							}}},
+						}},
+					},
+				},
+			},
+		}))
+
+		thread, err := post.GetCommentThread(ctx, "t1_c2", reddit.WithCommentContext(1), reddit.WithCommentSort("top"))
+		Expect(err).NotTo(HaveOccurred())
+
+		history := transport.GetCallHistory()
+		Expect(history[len(history)-1]).To(Equal("/r/golang/comments/abc123/_/c2?context=1&raw_json=1&sort=top"))
+
+		Expect(thread).To(HaveLen(1))
+		Expect(thread[0].ID).To(Equal("c1"))
+		Expect(thread[0].Replies).To(HaveLen(1))
+		focused := thread[0].Replies[0]
+		Expect(focused.ID).To(Equal("c2"))
+		Expect(focused.Body).To(Equal("focused"))
+		Expect(focused.Replies).To(HaveLen(1)) // The "more" placeholder is skipped
+		Expect(focused.Replies[0].ID).To(Equal("c3"))
+		Expect(focused.Replies[0].Replies).To(BeEmpty())
+	})
+
+	It("rejects an empty comment ID without a request", func() {
+		calls := transport.GetCallCount()
+		thread, err := post.GetCommentThread(ctx, "t1_")
+		Expect(err).To(MatchError(ContainSubstring("comment ID is empty")))
+		Expect(thread).To(BeNil())
+		Expect(transport.GetCallCount()).To(Equal(calls))
+	})
+
+	It("fails for a post without a client", func() {
+		thread, err := (&reddit.Post{ID: "abc123"}).GetCommentThread(ctx, "c2")
+		Expect(err).To(MatchError(ContainSubstring("no associated client")))
+		Expect(thread).To(BeNil())
+	})
+})
+
+var _ = Describe("Comment.GetReplies", func() {
+	var (
+		ctx       context.Context
+		transport *reddit.TestTransport
+		post      reddit.Post
+	)
+
+	comment := func(id, parentID string, replies any) map[string]any {
+		return map[string]any{"kind": "t1", "data": map[string]any{
+			"id": id, "body": "comment " + id, "parent_id": parentID,
+			"link_id": "t3_abc123", "subreddit": "golang", "replies": replies,
+		}}
+	}
+	thread := func(children ...any) *http.Response {
+		return reddit.CreateJSONResponse([]any{
+			map[string]any{"data": map[string]any{"children": []any{}}},
+			map[string]any{"data": map[string]any{"children": append([]any{}, children...)}},
+		})
+	}
+	replies := func(children ...any) map[string]any {
+		return map[string]any{"data": map[string]any{"children": children}}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		transport = reddit.NewTestTransport()
+		transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{
+				"children": []any{
+					map[string]any{"data": map[string]any{"id": "abc123", "title": "Go 1.23 released", "subreddit": "golang"}},
+				},
+			},
+		}))
+
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err := reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: transport}))
+		Expect(err).NotTo(HaveOccurred())
+
+		posts, err := reddit.NewSubreddit("golang", client).GetPosts(ctx, reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(posts).To(HaveLen(1))
+		post = posts[0]
+	})
+
+	It("expands a comment whose replies end in a more stub", func() {
+		transport.AddResponse("/r/golang/comments/abc123/_/c1", thread(
+			comment("c1", "t3_abc123", replies(
+				comment("c2", "t1_c1", ""),
+				map[string]any{"kind": "more", "data": map[string]any{
+					"id": "c3", "parent_id": "t1_c1", "children": []any{"c3", "c4"},
+				}},
+			)),
+		))
+		loaded, err := post.GetCommentThread(ctx, "c1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded).To(HaveLen(1))
+		parent := loaded[0]
+		Expect(parent.Replies).To(HaveLen(1))
+		Expect(parent.HasMoreReplies).To(BeTrue())
+
+		transport.AddResponse("/r/golang/comments/abc123/_/c1", thread(
+			comment("c1", "t3_abc123", replies(
+				comment("c2", "t1_c1", ""),
+				comment("c3", "t1_c1", replies(comment("c5", "t1_c3", ""))),
+				comment("c4", "t1_c1", ""),
+			)),
+		))
+		children, err := parent.GetReplies(ctx, reddit.WithCommentSort("new"))
+		Expect(err).NotTo(HaveOccurred())
+
+		history := transport.GetCallHistory()
+		Expect(history[len(history)-1]).To(Equal("/r/golang/comments/abc123/_/c1?raw_json=1&sort=new"))
+
+		Expect(children).To(HaveLen(3))
+		Expect(children[1].ID).To(Equal("c3"))
+		Expect(children[1].Replies).To(HaveLen(1))
+		Expect(children[1].Replies[0].ID).To(Equal("c5"))
+		Expect(parent.Replies).To(Equal(children))
+		Expect(parent.HasMoreReplies).To(BeFalse())
+	})
+
+	It("returns loaded replies without a request when none are pending", func() {
+		transport.AddResponse("/r/golang/comments/abc123/_/c1", thread(
+			comment("c1", "t3_abc123", replies(comment("c2", "t1_c1", ""))),
+		))
+		loaded, err := post.GetCommentThread(ctx, "c1")
+		Expect(err).NotTo(HaveOccurred())
+
+		calls := transport.GetCallCount()
+		children, err := loaded[0].GetReplies(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(children).To(HaveLen(1))
+		Expect(transport.GetCallCount()).To(Equal(calls))
+	})
+
+	It("returns ErrNotFound when the comment is gone", func() {
+		transport.AddResponse("/r/golang/comments/abc123/_/c1", thread(
+			comment("c1", "t3_abc123", ""),
+		))
+		loaded, err := post.GetCommentThread(ctx, "c1")
+		Expect(err).NotTo(HaveOccurred())
+
+		transport.AddResponse("/r/golang/comments/abc123/_/c1", thread())
+		children, err := loaded[0].GetReplies(ctx)
+		Expect(errors.Is(err, reddit.ErrNotFound)).To(BeTrue())
+		Expect(children).To(BeNil())
+	})
+
+	It("fails for a comment without a client", func() {
+		children, err := (&reddit.Comment{ID: "c1", LinkID: "t3_abc123"}).GetReplies(ctx)
+		Expect(err).To(MatchError(ContainSubstring("no associated client")))
+		Expect(children).To(BeNil())
+	})
+})
+
+var _ = Describe("GetCommentTree", func() {
+	var (
+		ctx       context.Context
+		transport *reddit.TestTransport
+		post      reddit.Post
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		transport = reddit.NewTestTransport()
+		transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{
+				"children": []any{
+					map[string]any{"data": map[string]any{"id": "abc123", "title": "Go 1.23 released", "subreddit": "golang"}},
+				},
+			},
+		}))
+
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err := reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: transport}))
+		Expect(err).NotTo(HaveOccurred())
+
+		posts, err := reddit.NewSubreddit("golang", client).GetPosts(ctx, reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(posts).To(HaveLen(1))
+		post = posts[0]
+
+		transport.AddResponse("/r/golang/comments/abc123", reddit.CreateJSONResponse([]any{
+			map[string]any{"data": map[string]any{"children": []any{}}},
+			map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"kind": "t1", "data": map[string]any{
+							"id": "c1", "body": "top", "parent_id": "t3_abc123",
+							"replies": map[string]any{"data": map[string]any{"children": []any{
+								map[string]any{"kind": "t1", "data": map[string]any{
+									"id": "c2", "body": "reply", "parent_id": "t1_c1", "replies": "",
+								}},
+							}}},
+						}},
+						map[string]any{"kind": "more", "data": map[string]any{
+							"id": "c3", "parent_id": "t3_abc123", "children": []any{"c3"},
+						}},
+					},
+				},
+			},
+		}))
+		transport.AddResponse("/api/morechildren", reddit.CreateJSONResponse(map[string]any{
+			"json": map[string]any{
+				"errors": []any{},
+				"data": map[string]any{"things": []any{
+					map[string]any{"kind": "t1", "data": map[string]any{"id": "c3", "body": "loaded", "parent_id": "t3_abc123"}},
+					map[string]any{"kind": "t1", "data": map[string]any{"id": "c4", "body": "loaded reply", "parent_id": "t1_c3"}},
+					map[string]any{"kind": "more", "data": map[string]any{"id": "c5", "parent_id": "t1_c4", "children": []any{"c5"}}},
+				}},
+			},
+		}))
+	})
+
+	It("returns the nested tree with the top-level more placeholder expanded once", func() {
+		tree, err := post.GetCommentTree(ctx, 2)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(tree).To(HaveLen(2))
+		Expect(tree[0].ID).To(Equal("c1"))
+		Expect(tree[0].Replies).To(HaveLen(1))
+		Expect(tree[0].Replies[0].ID).To(Equal("c2"))
+		Expect(tree[1].ID).To(Equal("c3"))
+		Expect(tree[1].Body).To(Equal("loaded"))
+		Expect(tree[1].Replies).To(HaveLen(1))
+		Expect(tree[1].Replies[0].ID).To(Equal("c4"))
+
+		var expansions []string
+		for _, call := range transport.GetCallHistory() {
+			if strings.HasPrefix(call, "/api/morechildren") {
+				expansions = append(expansions, call)
+			}
+		}
+		Expect(expansions).To(HaveLen(1))
+		Expect(expansions[0]).To(ContainSubstring("children=c3"))
+		Expect(expansions[0]).To(ContainSubstring("link_id=t3_abc123"))
+		Expect(expansions[0]).To(ContainSubstring("depth=2"))
+	})
+
+	It("drops replies below the maximum depth", func() {
+		tree, err := post.GetCommentTree(ctx, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tree).To(HaveLen(2))
+		Expect(tree[0].Replies).To(BeEmpty())
+		Expect(tree[1].Replies).To(BeEmpty())
+	})
+
+	It("fails for a post without a client", func() {
+		tree, err := (&reddit.Post{ID: "abc123"}).GetCommentTree(ctx, 2)
+		Expect(err).To(MatchError(ContainSubstring("no associated client")))
+		Expect(tree).To(BeNil())
+	})
 })
@@ -4,14 +4,36 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
+	"sync"
 	"time"
 
 	"golang.org/x/time/rate"
 )
 
-// RateLimiter handles rate limiting for Reddit API requests
+// adaptiveSmoothing is the weight given to the newest header-derived rate in adaptive mode
+const adaptiveSmoothing = 0.3
+
+// RateLimiter handles rate limiting for Reddit API requests.
+// It is safe for concurrent use; limit and burst are always updated together. Reddit
+// rate-limits per OAuth app, so clients using the same credentials can share one
+// RateLimiter through WithSharedRateLimiter.
 type RateLimiter struct {
+	mu      sync.Mutex // keeps limit and burst consistent across updates and reads
 	limiter *rate.Limiter
+
+	configuredRPS   float64   // rate the limiter was created with
+	configuredBurst int       // burst the limiter was created with
+	adaptive        bool      // smooth header-driven updates instead of applying them directly
+	smoothedRPS     float64   // exponential moving average of the header-derived rate
+	exhaustedUntil  time.Time // reset time announced by a response that exhausted the budget
+
+	hooks []*registeredHook // told when header updates reconfigure the limiter, replaced on change
+}
+
+// registeredHook is a hook added by addHook, compared by pointer so any hook can be removed
+type registeredHook struct {
+	hook RateLimitHook
 }
 
 // NewRateLimiter creates a new rate limiter with the specified rate and burst
@@ -19,12 +41,40 @@ func NewRateLimiter(requestsPerMinute, burst int) *RateLimiter {
 	// Convert requests per minute to requests per second
 	rps := float64(requestsPerMinute) / 60.0
 	return &RateLimiter{
-		limiter: rate.NewLimiter(rate.Limit(rps), burst),
+		limiter:         rate.NewLimiter(rate.Limit(rps), burst),
+		configuredRPS:   rps,
+		configuredBurst: burst,
+		smoothedRPS:     rps,
 	}
 }
 
-// Wait blocks until a request can be made according to the rate limit
+// NewAdaptiveRateLimiter creates a rate limiter that starts at the specified rate and burst and
+// converges on the budget advertised by the server's rate limit headers. Each update moves the
+// rate part of the way towards remaining / seconds-until-reset using an exponential moving
+// average, so the limit settles rather than jumping on every response.
+func NewAdaptiveRateLimiter(requestsPerMinute, burst int) *RateLimiter {
+	r := NewRateLimiter(requestsPerMinute, burst)
+	r.adaptive = true
+	return r
+}
+
+// Wait blocks until a request can be made according to the rate limit. Once the server has
+// reported the budget exhausted, it first waits until the announced reset time.
 func (r *RateLimiter) Wait(ctx context.Context) error {
+	if delay := r.resetDelay(); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			slog.WarnContext(ctx, "rate limit exceeded",
+				"error", ctx.Err(),
+				"reset_in", delay,
+			)
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
 	if err := r.limiter.Wait(ctx); err != nil {
 		slog.WarnContext(ctx, "rate limit exceeded",
 			"error", err,
@@ -38,6 +88,9 @@ func (r *RateLimiter) Wait(ctx context.Context) error {
 
 // Allow returns true if a request can be made according to the rate limit
 func (r *RateLimiter) Allow() bool {
+	if r.resetDelay() > 0 {
+		return false
+	}
 	return r.limiter.Allow()
 }
 
@@ -47,24 +100,83 @@ func (r *RateLimiter) Reserve() *rate.Reservation {
 	return r.limiter.Reserve()
 }
 
+// TokensAvailable returns the number of requests that can be made right now without waiting.
+// It can be fractional, and negative while reservations are waiting for tokens. Reading it does
+// not consume a token.
+func (r *RateLimiter) TokensAvailable() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.limiter.Tokens()
+}
+
+// DelayUntilAllow returns how long a request would have to wait for the rate limiter right now,
+// or 0 if it could be made immediately. Unlike Reserve, it does not take a token or otherwise
+// change the limiter's state. While the server reports the budget exhausted, it is at least the
+// time until the announced reset. It returns rate.InfDuration if the limiter can never allow a request.
+func (r *RateLimiter) DelayUntilAllow() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return max(r.tokenDelay(), r.resetDelayLocked())
+}
+
+// tokenDelay returns how long a request would have to wait for a token. r.mu must be held.
+func (r *RateLimiter) tokenDelay() time.Duration {
+	limit := r.limiter.Limit()
+	if limit == rate.Inf {
+		return 0
+	}
+	if r.limiter.Burst() < 1 {
+		return rate.InfDuration
+	}
+
+	missing := 1 - r.limiter.Tokens()
+	if missing <= 0 {
+		return 0
+	}
+	if limit <= 0 {
+		return rate.InfDuration
+	}
+	return time.Duration(missing / float64(limit) * float64(time.Second))
+}
+
 // UpdateLimit updates the rate limit based on the server response
 func (r *RateLimiter) UpdateLimit(remaining int, reset time.Time) {
 	r.UpdateLimitWithUsed(remaining, 0, reset)
 }
 
-// UpdateLimitWithUsed updates the rate limit based on the server response including used requests
+// UpdateLimitWithUsed updates the rate limit based on the server response including used requests.
+// The rate limit hooks of the clients using the limiter are told the new rate and burst.
 func (r *RateLimiter) UpdateLimitWithUsed(remaining, used int, reset time.Time) {
+	r.mu.Lock()
+	rps, burst, updated := r.updateLimitLocked(remaining, used, reset)
+	hooks := r.hooks
+	r.mu.Unlock()
+
+	// Called without the lock so hooks can inspect the limiter
+	if updated {
+		for _, registered := range hooks {
+			registered.hook.OnRateLimitReconfigured(rps*60, burst)
+		}
+	}
+}
+
+// updateLimitLocked applies a header update for UpdateLimitWithUsed, which holds r.mu. It returns
+// the new rate in requests per second and burst, and false if the update was skipped.
+func (r *RateLimiter) updateLimitLocked(remaining, used int, reset time.Time) (float64, int, bool) {
 	if remaining <= 0 {
-		// If we're out of requests, set a very low limit
+		// If we're out of requests, hold requests until the reset and set a very low limit
+		r.exhaustedUntil = reset
 		r.limiter.SetLimit(0.1) // One request every 10 seconds
 		r.limiter.SetBurst(1)
 		slog.Debug("rate limit exhausted, setting very low limit",
 			"remaining", remaining,
 			"used", used,
 			"reset", reset)
-		return
+		return 0.1, 1, true
 	}
 
+	r.exhaustedUntil = time.Time{}
+
 	// Calculate new rate based on remaining requests and reset time
 	duration := time.Until(reset)
 	if duration <= 0 {
@@ -73,11 +185,15 @@ func (r *RateLimiter) UpdateLimitWithUsed(remaining, used int, reset time.Time)
 			"used", used,
 			"reset", reset,
 			"duration", duration)
-		return
+		return 0, 0, false
 	}
 
 	// Calculate requests per second
 	rps := float64(remaining) / duration.Seconds()
+	if r.adaptive {
+		r.smoothedRPS = adaptiveSmoothing*rps + (1-adaptiveSmoothing)*r.smoothedRPS
+		rps = r.smoothedRPS
+	}
 	r.limiter.SetLimit(rate.Limit(rps))
 
 	// Set burst to min(remaining/10, 5) to allow some bursting but not too much
@@ -97,6 +213,64 @@ func (r *RateLimiter) UpdateLimitWithUsed(remaining, used int, reset time.Time)
 		"duration", duration,
 		"new_rps", rps,
 		"new_burst", burst)
+	return rps, burst, true
+}
+
+// addHook registers a client's rate limit hook to be told when header updates reconfigure the
+// limiter. A limiter shared through WithSharedRateLimiter tells the hooks of all its clients.
+// It returns a function that removes the hook again, called when the client is closed.
+func (r *RateLimiter) addHook(hook RateLimitHook) func() {
+	registered := &registeredHook{hook: hook}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Copied rather than appended in place, as UpdateLimitWithUsed reads a snapshot without the lock
+	r.hooks = append(slices.Clip(r.hooks), registered)
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.hooks = slices.DeleteFunc(slices.Clone(r.hooks), func(h *registeredHook) bool {
+			return h == registered
+		})
+	}
+}
+
+// resetDelay returns how long requests must wait for the reset announced when the server
+// reported the budget exhausted, or 0 if it has not or the reset has passed
+func (r *RateLimiter) resetDelay() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.resetDelayLocked()
+}
+
+// resetDelayLocked is resetDelay for callers already holding r.mu
+func (r *RateLimiter) resetDelayLocked() time.Duration {
+	if r.exhaustedUntil.IsZero() {
+		return 0
+	}
+	return max(time.Until(r.exhaustedUntil), 0)
+}
+
+// enableAdaptive switches the limiter to adaptive mode (see NewAdaptiveRateLimiter)
+func (r *RateLimiter) enableAdaptive() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.adaptive = true
+}
+
+// restoreConfigured returns an adaptive limiter to the rate and burst it was created with.
+// It is used when a response carries no rate limit headers to learn from.
+func (r *RateLimiter) restoreConfigured() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.adaptive {
+		return
+	}
+	r.smoothedRPS = r.configuredRPS
+	r.limiter.SetLimit(rate.Limit(r.configuredRPS))
+	r.limiter.SetBurst(r.configuredBurst)
 }
 
 // GetConfig returns the current rate limit configuration
@@ -104,6 +278,8 @@ func (r *RateLimiter) GetConfig() (requestsPerMinute float64, burst int) {
 	if r.limiter == nil {
 		return 0, 0
 	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	// Convert requests per second back to requests per minute
 	return float64(r.limiter.Limit()) * 60, r.limiter.Burst()
 }
@@ -1,6 +1,7 @@
 package reddit_test
 
 import (
+	"context"
 	"time"
 
 	"github.com/JohnPlummer/reddit-client/reddit"
@@ -145,6 +146,77 @@ var _ = Describe("RateLimiter", func() {
 		})
 	})
 
+	Describe("TokensAvailable and DelayUntilAllow", func() {
+		It("reports a full burst without delay", func() {
+			rateLimiter = reddit.NewRateLimiter(60, 5)
+
+			Expect(rateLimiter.TokensAvailable()).To(BeNumerically("~", 5, 0.01))
+			Expect(rateLimiter.DelayUntilAllow()).To(BeZero())
+		})
+
+		It("reports the wait after exhausting the burst", func() {
+			rateLimiter = reddit.NewRateLimiter(60, 5) // one token per second
+			for i := 0; i < 5; i++ {
+				Expect(rateLimiter.Allow()).To(BeTrue())
+			}
+
+			Expect(rateLimiter.TokensAvailable()).To(BeNumerically("~", 0, 0.05))
+			delay := rateLimiter.DelayUntilAllow()
+			Expect(delay).To(BeNumerically(">", 900*time.Millisecond))
+			Expect(delay).To(BeNumerically("<=", time.Second))
+		})
+
+		It("does not consume tokens", func() {
+			rateLimiter = reddit.NewRateLimiter(1, 1)
+
+			for i := 0; i < 3; i++ {
+				Expect(rateLimiter.DelayUntilAllow()).To(BeZero())
+				Expect(rateLimiter.TokensAvailable()).To(BeNumerically("~", 1, 0.01))
+			}
+			Expect(rateLimiter.Allow()).To(BeTrue())
+			Expect(rateLimiter.DelayUntilAllow()).To(BeNumerically(">", 59*time.Second))
+		})
+
+		It("includes the time until the reset once the budget is exhausted", func() {
+			rateLimiter = reddit.NewRateLimiter(60, 5)
+			rateLimiter.UpdateLimit(0, time.Now().Add(time.Hour))
+
+			Expect(rateLimiter.DelayUntilAllow()).To(BeNumerically(">", 59*time.Minute))
+			Expect(rateLimiter.Allow()).To(BeFalse())
+
+			// A response with budget left lifts the hold
+			rateLimiter.UpdateLimit(100, time.Now().Add(time.Minute))
+			Expect(rateLimiter.DelayUntilAllow()).To(BeNumerically("<", time.Second))
+		})
+
+		It("reports an infinite delay when the limiter can never refill", func() {
+			rateLimiter = reddit.NewRateLimiter(0, 1)
+			Expect(rateLimiter.Allow()).To(BeTrue())
+
+			Expect(rateLimiter.DelayUntilAllow()).To(Equal(rate.InfDuration))
+		})
+	})
+
+	Describe("Wait after the budget is exhausted", func() {
+		It("waits until the reset time", func() {
+			rateLimiter = reddit.NewRateLimiter(60, 5)
+			rateLimiter.UpdateLimit(0, time.Now().Add(200*time.Millisecond))
+
+			start := time.Now()
+			Expect(rateLimiter.Wait(context.Background())).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically(">=", 150*time.Millisecond))
+		})
+
+		It("returns the context error if the reset is too far away", func() {
+			rateLimiter = reddit.NewRateLimiter(60, 5)
+			rateLimiter.UpdateLimit(0, time.Now().Add(time.Hour))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			Expect(rateLimiter.Wait(ctx)).To(MatchError(context.DeadlineExceeded))
+		})
+	})
+
 	Describe("UpdateLimit", func() {
 		BeforeEach(func() {
 			rateLimiter = reddit.NewRateLimiter(60, 5) // Start with default values
@@ -453,6 +525,49 @@ var _ = Describe("RateLimiter", func() {
 		})
 	})
 
+	Describe("adaptive mode", func() {
+		BeforeEach(func() {
+			rateLimiter = reddit.NewAdaptiveRateLimiter(60, 5)
+		})
+
+		It("trends toward the server-advertised budget instead of snapping to it", func() {
+			// 600 requests remaining over 60 seconds advertises 600 requests per minute
+			previous, _ := rateLimiter.GetConfig()
+			for i := 0; i < 5; i++ {
+				rateLimiter.UpdateLimit(600, time.Now().Add(60*time.Second))
+				rpm, _ := rateLimiter.GetConfig()
+				Expect(rpm).To(BeNumerically(">", previous))
+				Expect(rpm).To(BeNumerically("<", 600))
+				previous = rpm
+			}
+
+			for i := 0; i < 20; i++ {
+				rateLimiter.UpdateLimit(600, time.Now().Add(60*time.Second))
+			}
+			rpm, _ := rateLimiter.GetConfig()
+			Expect(rpm).To(BeNumerically("~", 600, 10))
+		})
+
+		It("dampens oscillating header values", func() {
+			for i := 0; i < 20; i++ {
+				rateLimiter.UpdateLimit(600, time.Now().Add(60*time.Second))
+			}
+
+			// A single low reading only moves the rate part of the way down
+			rateLimiter.UpdateLimit(100, time.Now().Add(60*time.Second))
+			rpm, _ := rateLimiter.GetConfig()
+			Expect(rpm).To(BeNumerically(">", 400))
+			Expect(rpm).To(BeNumerically("<", 600))
+		})
+
+		It("still backs off immediately when the budget is exhausted", func() {
+			rateLimiter.UpdateLimit(0, time.Now().Add(60*time.Second))
+			rpm, burst := rateLimiter.GetConfig()
+			Expect(rpm).To(BeNumerically("~", 6.0, 0.1))
+			Expect(burst).To(Equal(1))
+		})
+	})
+
 	Describe("integration tests", func() {
 		BeforeEach(func() {
 			rateLimiter = reddit.NewRateLimiter(60, 3)
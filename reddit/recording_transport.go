@@ -0,0 +1,206 @@
+package reddit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// RecordingMode selects whether a RecordingTransport records real responses or replays them
+type RecordingMode int
+
+const (
+	// ReplayMode serves responses recorded earlier and fails requests that have no recording
+	ReplayMode RecordingMode = iota
+	// RecordMode sends requests to the real transport and saves each response to disk
+	RecordMode
+)
+
+// String returns the string representation of the recording mode
+func (m RecordingMode) String() string {
+	switch m {
+	case ReplayMode:
+		return "replay"
+	case RecordMode:
+		return "record"
+	default:
+		return "unknown"
+	}
+}
+
+// RecordingTransport implements http.RoundTripper for golden-file integration tests. In record
+// mode it forwards requests to Transport and saves every response as a JSON file in its
+// directory, keyed by method and endpoint (path and query, ignoring the host). In replay mode it
+// serves those files without touching the network, so tests run without live credentials.
+//
+// Request headers are never recorded, but response bodies are saved as-is, so use it for API
+// requests only and keep authentication on a separate transport (see WithAuthTransport).
+//
+// Example usage:
+//
+//	mode := reddit.ReplayMode
+//	if os.Getenv("REDDIT_RECORD") != "" {
+//		mode = reddit.RecordMode
+//	}
+//	recorder := reddit.NewRecordingTransport("testdata/recordings", mode)
+//	client, err := reddit.NewClient(auth, reddit.WithHTTPClient(&http.Client{Transport: recorder}))
+type RecordingTransport struct {
+	// Transport makes the real requests in record mode. Nil uses http.DefaultTransport.
+	Transport http.RoundTripper
+
+	dir  string
+	mode RecordingMode
+	mu   sync.Mutex // serializes writes to the recording directory
+}
+
+// recordedResponse is the on-disk format of a recorded response. JSON bodies are stored inline so
+// recordings stay readable and editable; other bodies are stored as text.
+type recordedResponse struct {
+	Method     string          `json:"method"`
+	Endpoint   string          `json:"endpoint"`
+	StatusCode int             `json:"status_code"`
+	Header     http.Header     `json:"header,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	BodyText   string          `json:"body_text,omitempty"`
+}
+
+// Ensure RecordingTransport implements the transport interface
+var _ HTTPTransport = (*RecordingTransport)(nil)
+
+// NewRecordingTransport creates a transport that records responses to dir or replays them from it
+func NewRecordingTransport(dir string, mode RecordingMode) *RecordingTransport {
+	return &RecordingTransport{
+		dir:  dir,
+		mode: mode,
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := req.URL.RequestURI()
+	path := filepath.Join(t.dir, recordingFileName(req.Method, endpoint))
+
+	if t.mode == ReplayMode {
+		return t.replay(req, path, endpoint)
+	}
+	return t.record(req, path, endpoint)
+}
+
+// replay serves the response recorded for the request
+func (t *RecordingTransport) replay(req *http.Request, path, endpoint string) (*http.Response, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("RecordingTransport.RoundTrip: no recording for %s %s: %w", req.Method, endpoint, err)
+	}
+
+	var recorded recordedResponse
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		return nil, fmt.Errorf("RecordingTransport.RoundTrip: decoding recording %s failed: %w", path, err)
+	}
+
+	body := []byte(recorded.BodyText)
+	if len(recorded.Body) > 0 {
+		body = recorded.Body
+	}
+	header := recorded.Header
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		StatusCode:    recorded.StatusCode,
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// record forwards the request to the real transport and saves its response
+func (t *RecordingTransport) record(req *http.Request, path, endpoint string) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	// Let the transport negotiate compression itself so the saved body is decompressed
+	req = req.Clone(req.Context())
+	req.Header.Del("Accept-Encoding")
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("RecordingTransport.RoundTrip: reading response failed: %w", err)
+	}
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+	header.Del("Content-Encoding")
+	header.Del("Content-Length")
+
+	recorded := recordedResponse{
+		Method:     req.Method,
+		Endpoint:   endpoint,
+		StatusCode: resp.StatusCode,
+		Header:     header,
+	}
+	if json.Valid(body) {
+		recorded.Body = body
+	} else {
+		recorded.BodyText = string(body)
+	}
+
+	if err := t.save(path, recorded); err != nil {
+		return nil, fmt.Errorf("RecordingTransport.RoundTrip: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+// save writes a recorded response to path, creating the recording directory if needed
+func (t *RecordingTransport) save(path string, recorded recordedResponse) error {
+	data, err := json.MarshalIndent(recorded, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding recording failed: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := os.MkdirAll(t.dir, 0o755); err != nil {
+		return fmt.Errorf("creating recording directory failed: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("writing recording failed: %w", err)
+	}
+	return nil
+}
+
+// recordingFileName returns the file name of the recording for a request. A readable prefix is
+// followed by a hash of the method and endpoint, so distinct queries get distinct files.
+func recordingFileName(method, endpoint string) string {
+	sum := sha256.Sum256([]byte(method + " " + endpoint))
+
+	path, _, _ := strings.Cut(endpoint, "?")
+	slug := strings.Trim(strings.NewReplacer("/", "_", ".", "_").Replace(path), "_")
+	if len(slug) > 60 {
+		slug = slug[:60]
+	}
+
+	return fmt.Sprintf("%s_%s_%s.json", strings.ToLower(method), slug, hex.EncodeToString(sum[:8]))
+}
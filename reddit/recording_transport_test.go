@@ -0,0 +1,104 @@
+package reddit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/JohnPlummer/reddit-client/reddit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RecordingTransport", func() {
+	var (
+		dir      string
+		server   *httptest.Server
+		requests atomic.Int32
+		auth     *reddit.Auth
+	)
+
+	BeforeEach(func() {
+		dir = filepath.Join(GinkgoT().TempDir(), "recordings")
+		requests.Store(0)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Set-Cookie", "session=secret")
+			switch r.URL.Path {
+			case "/r/golang.json":
+				_, _ = w.Write([]byte(`{"data": {"children": [{"data": {"id": "rec1", "title": "Recorded Post", "subreddit": "golang"}}], "after": null}}`))
+			case "/r/golang/about.json":
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not Found", "error": 404}`))
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+		DeferCleanup(server.Close)
+
+		var err error
+		auth, err = reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(reddit.NewTestTransport()))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	newSubreddit := func(mode reddit.RecordingMode) *reddit.Subreddit {
+		client, err := reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: reddit.NewRecordingTransport(dir, mode)}),
+			reddit.WithBaseURL(server.URL),
+			reddit.WithNoRetries(),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		return reddit.NewSubreddit("golang", client)
+	}
+
+	It("replays recorded responses without contacting the server", func() {
+		recorded, err := newSubreddit(reddit.RecordMode).GetPosts(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorded).To(HaveLen(1))
+		Expect(requests.Load()).To(Equal(int32(1)))
+
+		server.Close()
+
+		replayed, err := newSubreddit(reddit.ReplayMode).GetPosts(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replayed).To(HaveLen(1))
+		Expect(replayed[0].ID).To(Equal("rec1"))
+		Expect(replayed[0].Title).To(Equal("Recorded Post"))
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+
+	It("replays error responses with their status code", func() {
+		_, err := newSubreddit(reddit.RecordMode).GetInfo(context.Background())
+		Expect(reddit.IsNotFoundError(err)).To(BeTrue())
+
+		_, err = newSubreddit(reddit.ReplayMode).GetInfo(context.Background())
+		Expect(reddit.IsNotFoundError(err)).To(BeTrue())
+	})
+
+	It("saves readable recordings without cookies", func() {
+		_, err := newSubreddit(reddit.RecordMode).GetPosts(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+
+		data, err := os.ReadFile(files[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"endpoint": "/r/golang.json?limit=100\u0026raw_json=1"`))
+		Expect(string(data)).To(ContainSubstring(`"title": "Recorded Post"`))
+		Expect(string(data)).NotTo(ContainSubstring("session=secret"))
+	})
+
+	It("fails requests without a recording in replay mode", func() {
+		_, err := newSubreddit(reddit.ReplayMode).GetPosts(context.Background())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("no recording for GET /r/golang.json"))
+		Expect(requests.Load()).To(BeZero())
+	})
+})
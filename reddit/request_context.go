@@ -0,0 +1,111 @@
+package reddit
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+)
+
+// RequestIDHeader is the header that carries the request ID set by ContextWithRequestID
+const RequestIDHeader = "X-Request-ID"
+
+// CorrelationIDLogKey is the log attribute that carries the correlation ID set by ContextWithCorrelationID
+const CorrelationIDLogKey = "correlation_id"
+
+// requestHeadersKey is the context key for headers attached with ContextWithHeader
+type requestHeadersKey struct{}
+
+// correlationIDKey is the context key for the ID attached with ContextWithCorrelationID
+type correlationIDKey struct{}
+
+// ContextWithHeader returns a copy of ctx that makes the client set the header on every HTTP
+// request issued with it, including retry attempts and every page of a paginated call. Headers
+// added to the same context accumulate; setting a key again replaces its value. The headers are
+// set before request interceptors run, so interceptors can read or override them. They can
+// replace the client's User-Agent but not its Authorization header.
+func ContextWithHeader(ctx context.Context, key, value string) context.Context {
+	parent, _ := ctx.Value(requestHeadersKey{}).(http.Header)
+	header := parent.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set(key, value)
+	return context.WithValue(ctx, requestHeadersKey{}, header)
+}
+
+// ContextWithRequestID returns a copy of ctx that makes the client send id in the X-Request-ID
+// header of every HTTP request issued with it, so all attempts of a call can be traced together.
+// RequestIDRequestInterceptor keeps an ID set this way instead of generating one.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return ContextWithHeader(ctx, RequestIDHeader, id)
+}
+
+// ContextWithUserAgent returns a copy of ctx that makes the client send userAgent instead of the
+// one configured with WithUserAgent for requests issued with it
+func ContextWithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return ContextWithHeader(ctx, "User-Agent", userAgent)
+}
+
+// RequestIDFromContext returns the request ID set by ContextWithRequestID, if any
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	header, _ := ctx.Value(requestHeadersKey{}).(http.Header)
+	id := header.Get(RequestIDHeader)
+	return id, id != ""
+}
+
+// ContextWithCorrelationID returns a copy of ctx that makes the client add id as the
+// correlation_id attribute of every log record it writes while handling calls made with it,
+// including rate limit, retry and pagination logs, so the logs of concurrent calls can be told
+// apart. Unlike ContextWithRequestID, nothing is sent to Reddit.
+func ContextWithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFromContext returns the correlation ID set by ContextWithCorrelationID, if any
+func CorrelationIDFromContext(ctx context.Context) (string, bool) {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id, id != ""
+}
+
+// NewCorrelationHandler wraps handler so that records logged with a context carrying a
+// correlation ID (see ContextWithCorrelationID) get it as the correlation_id attribute. The client
+// applies it to the logger set with WithLogger; wrap the default logger's handler with it to get
+// the same attribute from LoggingRateLimitHook and the example interceptors:
+//
+//	slog.SetDefault(slog.New(reddit.NewCorrelationHandler(slog.Default().Handler())))
+func NewCorrelationHandler(handler slog.Handler) slog.Handler {
+	if _, ok := handler.(*correlationHandler); ok {
+		return handler
+	}
+	return &correlationHandler{Handler: handler}
+}
+
+// correlationHandler is the slog.Handler returned by NewCorrelationHandler
+type correlationHandler struct {
+	slog.Handler
+}
+
+// Handle adds the context's correlation ID to the record and passes it on
+func (h *correlationHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id, ok := CorrelationIDFromContext(ctx); ok {
+		record = record.Clone()
+		record.AddAttrs(slog.String(CorrelationIDLogKey, id))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs returns a correlation handler wrapping the underlying handler with attrs
+func (h *correlationHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &correlationHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup returns a correlation handler wrapping the underlying handler with the group
+func (h *correlationHandler) WithGroup(name string) slog.Handler {
+	return &correlationHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// contextHeaders returns the headers attached to ctx with ContextWithHeader, or nil if there are none
+func contextHeaders(ctx context.Context) http.Header {
+	header, _ := ctx.Value(requestHeadersKey{}).(http.Header)
+	return header
+}
@@ -0,0 +1,227 @@
+package reddit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/JohnPlummer/reddit-client/reddit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request context", func() {
+	var (
+		transport *reddit.TestTransport
+		client    *reddit.Client
+		headers   []http.Header
+	)
+
+	BeforeEach(func() {
+		transport = reddit.NewTestTransport()
+		headers = nil
+
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err = reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithUserAgent("test-bot/1.0"),
+			reddit.WithRetries(2),
+			reddit.WithRetryDelay(time.Millisecond),
+			reddit.WithRequestInterceptor(func(req *http.Request) error {
+				headers = append(headers, req.Header.Clone())
+				return nil
+			}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	queueRetriedListing := func() {
+		transport.AddResponseToQueue("/r/golang.json", &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"error": "unavailable"}`)),
+		})
+		transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{"children": []any{}, "after": nil},
+		}))
+	}
+
+	It("sends the request ID on every retry attempt", func() {
+		queueRetriedListing()
+		ctx := reddit.ContextWithRequestID(context.Background(), "trace-123")
+
+		_, err := reddit.NewSubreddit("golang", client).GetPosts(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(headers).To(HaveLen(2))
+		for _, header := range headers {
+			Expect(header.Get(reddit.RequestIDHeader)).To(Equal("trace-123"))
+		}
+	})
+
+	It("accumulates headers and overrides the user agent but not the credentials", func() {
+		queueRetriedListing()
+		ctx := reddit.ContextWithHeader(context.Background(), "X-Tenant", "acme")
+		ctx = reddit.ContextWithUserAgent(ctx, "tenant-bot/2.0")
+		ctx = reddit.ContextWithHeader(ctx, "Authorization", "Bearer stolen")
+
+		_, err := reddit.NewSubreddit("golang", client).GetPosts(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(headers).To(HaveLen(2))
+		for _, header := range headers {
+			Expect(header.Get("X-Tenant")).To(Equal("acme"))
+			Expect(header.Get("User-Agent")).To(Equal("tenant-bot/2.0"))
+			Expect(header.Get("Authorization")).To(Equal("Bearer test_token"))
+		}
+	})
+
+	It("keeps a context request ID in RequestIDRequestInterceptor", func() {
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err = reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithRequestInterceptor(reddit.RequestIDRequestInterceptor(reddit.RequestIDHeader)),
+			reddit.WithRequestInterceptor(func(req *http.Request) error {
+				headers = append(headers, req.Header.Clone())
+				return nil
+			}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{"children": []any{}, "after": nil},
+		}))
+		ctx := reddit.ContextWithRequestID(context.Background(), "trace-123")
+
+		_, err = reddit.NewSubreddit("golang", client).GetPosts(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(headers).To(HaveLen(1))
+		Expect(headers[0].Get(reddit.RequestIDHeader)).To(Equal("trace-123"))
+	})
+
+	It("sends default headers that call headers and interceptors can override", func() {
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err = reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithDefaultHeaders(map[string]string{
+				"Accept":           "application/json",
+				"X-Client-Version": "1.0.0",
+				"X-Tenant":         "default",
+				"Authorization":    "Bearer stolen",
+			}),
+			reddit.WithRequestInterceptor(func(req *http.Request) error {
+				req.Header.Set("X-Client-Version", "2.0.0")
+				headers = append(headers, req.Header.Clone())
+				return nil
+			}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+			"data": map[string]any{"children": []any{}, "after": nil},
+		}))
+		ctx := reddit.ContextWithHeader(context.Background(), "X-Tenant", "acme")
+
+		_, err = reddit.NewSubreddit("golang", client).GetPosts(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(headers).To(HaveLen(1))
+		Expect(headers[0].Get("Accept")).To(Equal("application/json"))
+		Expect(headers[0].Get("X-Client-Version")).To(Equal("2.0.0"))
+		Expect(headers[0].Get("X-Tenant")).To(Equal("acme"))
+		Expect(headers[0].Get("Authorization")).To(Equal("Bearer test_token"))
+	})
+
+	It("leaves the Authorization header to interceptors with WithManualAuthorization", func() {
+		transport := &listingTransport{}
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		var before, after []string
+		client, err = reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithManualAuthorization(),
+			reddit.WithRequestInterceptor(func(req *http.Request) error {
+				before = append(before, req.Header.Get("Authorization"))
+				req.Header.Set("Authorization", "Signature keyId=proxy")
+				return nil
+			}),
+			reddit.WithRequestInterceptor(func(req *http.Request) error {
+				after = append(after, req.Header.Get("Authorization"))
+				return nil
+			}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = reddit.NewSubreddit("golang", client).GetPosts(context.Background(), reddit.WithSubredditLimit(1))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(before).To(Equal([]string{""}))
+		Expect(after).To(Equal([]string{"Signature keyId=proxy"}))
+		Expect(transport.tokenRequests.Load()).To(BeZero())
+	})
+
+	It("reads the request ID back from the context", func() {
+		_, ok := reddit.RequestIDFromContext(context.Background())
+		Expect(ok).To(BeFalse())
+
+		id, ok := reddit.RequestIDFromContext(reddit.ContextWithRequestID(context.Background(), "trace-123"))
+		Expect(ok).To(BeTrue())
+		Expect(id).To(Equal("trace-123"))
+	})
+
+	It("adds the correlation ID to every log written while handling a call", func() {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+		auth, err := reddit.NewAuth("test_id", "test_secret", reddit.WithAuthTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+		client, err = reddit.NewClient(auth,
+			reddit.WithHTTPClient(&http.Client{Transport: transport}),
+			reddit.WithRetries(2),
+			reddit.WithRetryDelay(time.Millisecond),
+			reddit.WithLogger(logger),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		buf.Reset()
+
+		queueRetriedListing()
+		ctx := reddit.ContextWithCorrelationID(context.Background(), "fetch-7")
+		_, err = reddit.NewSubreddit("golang", client).GetPosts(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		Expect(buf.String()).To(ContainSubstring("received retryable error, retrying"))
+		Expect(buf.String()).To(ContainSubstring("request successful"))
+		for _, line := range lines {
+			var record map[string]any
+			Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			Expect(record).To(HaveKeyWithValue(reddit.CorrelationIDLogKey, "fetch-7"), line)
+		}
+
+		id, ok := reddit.CorrelationIDFromContext(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(id).To(Equal("fetch-7"))
+	})
+
+	It("leaves logs untagged without a correlation ID", func() {
+		var buf bytes.Buffer
+		logger := slog.New(reddit.NewCorrelationHandler(slog.NewJSONHandler(&buf, nil)))
+
+		logger.InfoContext(context.Background(), "untagged")
+		logger.InfoContext(reddit.ContextWithCorrelationID(context.Background(), "fetch-8"), "tagged")
+
+		Expect(buf.String()).To(ContainSubstring(`"msg":"untagged"}`))
+		Expect(buf.String()).To(ContainSubstring(`"msg":"tagged","correlation_id":"fetch-8"`))
+	})
+
+	It("does not leak headers into the parent context", func() {
+		parent := reddit.ContextWithHeader(context.Background(), "X-Tenant", "acme")
+		_ = reddit.ContextWithRequestID(parent, "trace-123")
+
+		_, ok := reddit.RequestIDFromContext(parent)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,24 @@
+package reddit
+
+import "time"
+
+// StreamOptions configures how StreamPosts and StreamComments deliver items. The zero value
+// streams the existing items on an unbuffered channel and then closes it.
+type StreamOptions struct {
+	// BufferSize is the capacity of the channel items are streamed on, letting the stream fetch
+	// ahead of a slow consumer. Values below 1 leave the channel unbuffered.
+	BufferSize int
+
+	// PollInterval keeps the stream open after the existing items have been streamed ("live tail"
+	// mode), polling every interval for items added since and emitting them oldest first until
+	// the context is cancelled. Values below 1 end the stream once the existing items are streamed.
+	PollInterval time.Duration
+}
+
+// paginationOptions returns the default pagination options with the stream's buffer and limit
+func (o StreamOptions) paginationOptions(limit int) PaginationOptions {
+	opts := DefaultPaginationOptions()
+	opts.Limit = limit
+	opts.BufferSize = max(o.BufferSize, 0)
+	return opts
+}
@@ -0,0 +1,34 @@
+package reddit
+
+// SubmitOption is a function type for modifying post submission parameters
+type SubmitOption func(params map[string]string)
+
+// WithSelfText returns a SubmitOption that submits a self (text) post with the given markdown body
+func WithSelfText(text string) SubmitOption {
+	return func(params map[string]string) {
+		params["kind"] = "self"
+		params["text"] = text
+	}
+}
+
+// WithURL returns a SubmitOption that submits a link post pointing at the given URL
+func WithURL(url string) SubmitOption {
+	return func(params map[string]string) {
+		params["kind"] = "link"
+		params["url"] = url
+	}
+}
+
+// WithNSFW returns a SubmitOption that marks the submitted post as NSFW
+func WithNSFW() SubmitOption {
+	return func(params map[string]string) {
+		params["nsfw"] = "true"
+	}
+}
+
+// WithSpoiler returns a SubmitOption that marks the submitted post as a spoiler
+func WithSpoiler() SubmitOption {
+	return func(params map[string]string) {
+		params["spoiler"] = "true"
+	}
+}
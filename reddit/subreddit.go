@@ -3,7 +3,10 @@ package reddit
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // PostGetter defines the interface for fetching posts from Reddit
@@ -19,7 +22,46 @@ type Subreddit struct {
 	client *Client
 }
 
-// NewSubreddit creates a new Subreddit instance
+// SubredditInfo represents the metadata of a subreddit as returned by /r/{name}/about.json
+type SubredditInfo struct {
+	ID                string `json:"id"`
+	Name              string `json:"display_name"`
+	Title             string `json:"title"`
+	PublicDescription string `json:"public_description"`
+	Subscribers       int    `json:"subscribers"`
+	ActiveUserCount   int    `json:"active_user_count"`
+	Over18            bool   `json:"over18"`
+	CreatedUTC        int64  `json:"created_utc"`
+}
+
+// Fullname returns the Reddit fullname identifier for this subreddit (t5_<id>)
+func (i SubredditInfo) Fullname() string {
+	return "t5_" + i.ID
+}
+
+// Rule represents one of a subreddit's rules as returned by /r/{name}/about/rules.json
+type Rule struct {
+	ShortName   string `json:"short_name"`
+	Description string `json:"description"` // markdown
+	Kind        string `json:"kind"`        // what the rule applies to: "link", "comment" or "all"
+	Priority    int    `json:"priority"`    // position of the rule in the subreddit's list, starting at 0
+}
+
+// PostPage is the result of GetPostsWithCursor: the posts fetched and the cursor to resume from
+type PostPage struct {
+	Posts []Post
+	After string // cursor to resume from, empty once the listing is exhausted
+}
+
+// UserEntry represents a user in one of a subreddit's user lists, such as its moderators
+type UserEntry struct {
+	Name        string   `json:"name"`
+	ID          string   `json:"id"`                        // the user's fullname (t2_<id>)
+	Permissions []string `json:"mod_permissions,omitempty"` // moderators only: permissions such as "all", "posts" or "wiki"
+}
+
+// NewSubreddit creates a new Subreddit instance. The name is validated when a request is made,
+// which then fails with an error wrapping ErrInvalidSubredditName for a malformed name.
 func NewSubreddit(name string, client *Client) *Subreddit {
 	return &Subreddit{
 		Name:   name,
@@ -27,8 +69,175 @@ func NewSubreddit(name string, client *Client) *Subreddit {
 	}
 }
 
+// subredditNamePattern matches a single subreddit name as Reddit allows it
+var subredditNamePattern = regexp.MustCompile(`^[A-Za-z0-9_]{1,21}$`)
+
+// validateSubredditName returns an error wrapping ErrInvalidSubredditName unless name is a valid
+// subreddit name, or several valid names joined with "+" as created by NewMultiSubreddit
+func validateSubredditName(name string) error {
+	for _, part := range strings.Split(name, "+") {
+		if !subredditNamePattern.MatchString(part) {
+			return fmt.Errorf("%w: %q", ErrInvalidSubredditName, name)
+		}
+	}
+	return nil
+}
+
+// NewMultiSubreddit creates a Subreddit that combines several subreddits into one listing using
+// Reddit's multireddit syntax (/r/golang+rust), so their posts come back in a single request.
+// It returns an error wrapping ErrInvalidSubredditName if names is empty or any name is invalid.
+// Endpoints about a single community, such as GetInfo and Submit, do not support combined names.
+func NewMultiSubreddit(names []string, client *Client) (*Subreddit, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("subreddit.NewMultiSubreddit: no names given: %w", ErrInvalidSubredditName)
+	}
+
+	trimmed := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if !subredditNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("subreddit.NewMultiSubreddit: %w: %q", ErrInvalidSubredditName, name)
+		}
+		trimmed = append(trimmed, name)
+	}
+
+	return NewSubreddit(strings.Join(trimmed, "+"), client), nil
+}
+
 // GetPosts fetches posts from the subreddit with optional pagination and filtering
 func (s *Subreddit) GetPosts(ctx context.Context, opts ...SubredditOption) ([]Post, error) {
+	postOpts, err := postOptions(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("subreddit.GetPosts: %w", err)
+	}
+
+	return s.client.getPosts(ctx, s.Name, postOpts...)
+}
+
+// GetHotPosts fetches posts from the subreddit's hot listing. It takes the same options as
+// GetPosts; a sort set with WithSort is ignored.
+func (s *Subreddit) GetHotPosts(ctx context.Context, opts ...SubredditOption) ([]Post, error) {
+	return s.getSortedPosts(ctx, SortHot, "subreddit.GetHotPosts", opts...)
+}
+
+// GetNewPosts fetches posts from the subreddit's new listing, newest first. It takes the same
+// options as GetPosts; a sort set with WithSort is ignored.
+func (s *Subreddit) GetNewPosts(ctx context.Context, opts ...SubredditOption) ([]Post, error) {
+	return s.getSortedPosts(ctx, SortNew, "subreddit.GetNewPosts", opts...)
+}
+
+// GetTopPosts fetches posts from the subreddit's top listing. Combine it with WithTimeframe to
+// pick the time window. It takes the same options as GetPosts; a sort set with WithSort is ignored.
+func (s *Subreddit) GetTopPosts(ctx context.Context, opts ...SubredditOption) ([]Post, error) {
+	return s.getSortedPosts(ctx, SortTop, "subreddit.GetTopPosts", opts...)
+}
+
+// GetRisingPosts fetches posts from the subreddit's rising listing. It takes the same options as
+// GetPosts; a sort set with WithSort is ignored.
+func (s *Subreddit) GetRisingPosts(ctx context.Context, opts ...SubredditOption) ([]Post, error) {
+	return s.getSortedPosts(ctx, SortRising, "subreddit.GetRisingPosts", opts...)
+}
+
+// getSortedPosts fetches posts from the dedicated endpoint of the sort order. The sort parameter
+// is dropped from the options, since the endpoint already selects it.
+func (s *Subreddit) getSortedPosts(ctx context.Context, sort PostSort, method string, opts ...SubredditOption) ([]Post, error) {
+	opts = append(opts, func(params map[string]string) { delete(params, "sort") })
+	postOpts, err := postOptions(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+
+	return s.client.getPosts(ctx, s.Name, append(postOpts, withListing(sort))...)
+}
+
+// GetPostsWithCursor fetches posts like GetPosts and also returns the cursor to resume from, so
+// callers can continue later, for example with WithAfterCursor, without re-deriving it. The cursor
+// is the after token of the last page that returned posts, or the fullname of the last post when
+// the limit ends mid-page, so it is kept when a later page comes back empty. It is empty once the
+// listing is exhausted. If no post is returned, it is the cursor the call started from.
+// GetPosts is unchanged and discards the cursor.
+func (s *Subreddit) GetPostsWithCursor(ctx context.Context, opts ...SubredditOption) (*PostPage, error) {
+	postOpts, err := postOptions(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("subreddit.GetPostsWithCursor: %w", err)
+	}
+
+	params := make(map[string]string)
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	posts, after, err := s.postsFromCursor(ctx, params["after"], postOpts...)
+	page := &PostPage{Posts: posts, After: after}
+	if err != nil {
+		return page, fmt.Errorf("subreddit.GetPostsWithCursor: %w", err)
+	}
+	return page, nil
+}
+
+// GetPostsPage fetches a single page of posts from the subreddit, starting after the given cursor
+// (the fullname of a post such as "t3_abc123", or an empty string for the first page). Alongside
+// the posts it returns the listing's before and after cursors, so callers doing their own paging,
+// such as a UI with next and previous buttons, can move in both directions: pass the after cursor
+// to GetPostsPage for the next page, and the before cursor with WithBeforeCursor for the previous
+// one. Either cursor is empty when Reddit reports no page in that direction.
+func (s *Subreddit) GetPostsPage(ctx context.Context, after string, opts ...SubredditOption) ([]Post, string, string, error) {
+	params := map[string]string{
+		"limit": "100", // Default limit
+	}
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	// Report invalid options before making any request
+	if err := validateSubredditParams(params); err != nil {
+		return nil, "", "", fmt.Errorf("subreddit.GetPostsPage: %w", err)
+	}
+
+	if _, ok := params["before"]; ok {
+		delete(params, "after")
+	} else if after != "" {
+		params["after"] = after
+	}
+
+	// As in postOptions, the sort selects the listing rather than being sent as a parameter
+	sort := PostSort(params["sort"])
+	delete(params, "sort")
+
+	posts, before, next, err := s.client.getPostsPageWithCursors(ctx, s.Name, sort, params)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("subreddit.GetPostsPage: %w", err)
+	}
+	return posts, before, next, nil
+}
+
+// StreamPosts fetches posts from the subreddit page by page, emitting each post on the returned
+// channel as soon as its page has been fetched. This keeps memory bounded for long-running consumers.
+//
+// Both channels are closed when pagination ends. If pagination fails or the context is cancelled,
+// the error is sent on the error channel before the channels are closed.
+//
+// stream.BufferSize sets the capacity of the post channel. stream.PollInterval keeps the stream
+// open once the listing has been streamed, polling for posts submitted since the newest one and
+// emitting them oldest first until ctx is cancelled; use it with WithSort("new"). A sort set with
+// WithSort selects the listing, such as /r/{subreddit}/new.json. A limit only applies to the posts
+// streamed before polling starts.
+func (s *Subreddit) StreamPosts(ctx context.Context, stream StreamOptions, opts ...SubredditOption) (<-chan Post, <-chan error) {
+	postOpts, err := postOptions(opts...)
+	if err != nil {
+		posts := make(chan Post)
+		errs := make(chan error, 1)
+		errs <- fmt.Errorf("subreddit.StreamPosts: %w", err)
+		close(posts)
+		close(errs)
+		return posts, errs
+	}
+
+	return s.client.streamPosts(ctx, s.Name, stream, postOpts...)
+}
+
+// postOptions applies the subreddit options, reports invalid values, and converts them to PostOptions
+func postOptions(opts ...SubredditOption) ([]PostOption, error) {
 	params := map[string]string{
 		"limit": "100", // Default limit
 	}
@@ -38,6 +247,11 @@ func (s *Subreddit) GetPosts(ctx context.Context, opts ...SubredditOption) ([]Po
 		opt(params)
 	}
 
+	// Report invalid options before making any request
+	if err := validateSubredditParams(params); err != nil {
+		return nil, err
+	}
+
 	// Convert params to PostOptions
 	var postOpts []PostOption
 
@@ -50,17 +264,192 @@ func (s *Subreddit) GetPosts(ctx context.Context, opts ...SubredditOption) ([]Po
 
 	// Handle after parameter
 	if after, ok := params["after"]; ok {
-		postOpts = append(postOpts, WithAfter(&Post{ID: after[3:]})) // Remove "t3_" prefix
+		postOpts = append(postOpts, withPostParam("after", after))
 	}
 
-	return s.client.getPosts(ctx, s.Name, postOpts...)
+	// Reddit ignores the sort parameter on the subreddit listing, so fetch the sort's own listing
+	// instead, which is also the one polling for newer posts must follow
+	if sort, ok := params["sort"]; ok {
+		postOpts = append(postOpts, withListing(PostSort(sort)))
+	}
+
+	// Handle before, timeframe, subreddit detail, NSFW and geo parameters
+	for _, key := range []string{"before", "t", "sr_detail", "include_over_18", "g"} {
+		if value, ok := params[key]; ok {
+			postOpts = append(postOpts, withPostParam(key, value))
+		}
+	}
+
+	return postOpts, nil
 }
 
 // GetPostsAfter fetches posts from the subreddit that come after the specified post.
 // This method will automatically fetch multiple pages as needed up to the specified limit.
 // Set limit to 0 to fetch all available posts (use with caution).
-func (s *Subreddit) GetPostsAfter(ctx context.Context, after *Post, limit int) ([]Post, error) {
-	return s.client.getPosts(ctx, s.Name, WithAfter(after), WithLimit(limit))
+//
+// Per-request timeouts do not bound the whole operation. To put a single deadline on a
+// long crawl, pass a context created with context.WithTimeout, or use GetPostsAfterTimeout.
+// If the context ends mid-crawl, the posts collected so far are returned with an error wrapping
+// the context error, unless the client was created with WithPartialResultsOnCancel(false).
+// Other fetch errors return nil posts unless the client was created with WithPartialResultsOnError.
+//
+// Pass WithPostCallback to handle each post as soon as its page has been parsed.
+func (s *Subreddit) GetPostsAfter(ctx context.Context, after *Post, limit int, opts ...PostOption) ([]Post, error) {
+	opts = append([]PostOption{WithAfter(after), WithLimit(limit)}, opts...)
+	return s.client.getPosts(ctx, s.Name, opts...)
+}
+
+// GetPostsFromCursor fetches posts from the subreddit starting after the given cursor, the
+// fullname of a post such as "t3_abc123", like GetPostsAfter. An empty cursor starts from the top
+// of the listing. Pass WithLimit to set how many posts to fetch (100 by default).
+//
+// The result's After field holds the cursor to pass on the next call to continue where this one
+// stopped, so callers can persist it between runs, as with GetPostsWithCursor. It is empty once
+// the listing is exhausted. If no post is returned, After is the cursor that was passed in.
+func (s *Subreddit) GetPostsFromCursor(ctx context.Context, after string, opts ...PostOption) (*PaginationResult[Post], error) {
+	if after != "" {
+		opts = append([]PostOption{withPostParam("after", after)}, opts...)
+	}
+
+	posts, next, err := s.postsFromCursor(ctx, after, opts...)
+	result := &PaginationResult[Post]{Items: posts, After: next}
+	if err != nil {
+		return result, fmt.Errorf("subreddit.GetPostsFromCursor: %w", err)
+	}
+	return result, nil
+}
+
+// postsFromCursor fetches posts for GetPostsFromCursor and GetPostsWithCursor, returning them
+// with the cursor to resume from, or the cursor the call started from if no post was returned
+func (s *Subreddit) postsFromCursor(ctx context.Context, after string, opts ...PostOption) ([]Post, string, error) {
+	posts, next, err := s.client.getPostsWithPagination(ctx, s.Name, s.client.paginationOptions(), opts...)
+	if len(posts) == 0 {
+		next = after
+	}
+	return posts, next, err
+}
+
+// GetPostsBefore fetches posts from the subreddit that come before the specified post in the
+// listing, such as posts submitted since it in the "new" listing. Each page is requested before
+// the first post of the previous one, so posts are returned page by page moving away from the
+// given post, each page in listing order. Set limit to 0 to fetch all available posts.
+//
+// It follows the same cancellation and callback behavior as GetPostsAfter.
+func (s *Subreddit) GetPostsBefore(ctx context.Context, before *Post, limit int, opts ...PostOption) ([]Post, error) {
+	opts = append([]PostOption{WithBefore(before), WithLimit(limit)}, opts...)
+	return s.client.getPosts(ctx, s.Name, opts...)
+}
+
+// GetPostsAfterTimeout fetches posts like GetPostsAfter but bounds the entire pagination by timeout.
+// If the deadline is reached mid-crawl, the posts collected so far are returned together with
+// an error wrapping context.DeadlineExceeded, whatever the client's WithPartialResultsOnCancel setting.
+func (s *Subreddit) GetPostsAfterTimeout(ctx context.Context, after *Post, limit int, timeout time.Duration) ([]Post, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	paginationOpts := DefaultPaginationOptions()
+	paginationOpts.PartialResultsOnCancel = true
+
+	posts, _, err := s.client.getPostsWithPagination(ctx, s.Name, paginationOpts, WithAfter(after), WithLimit(limit))
+	if err != nil {
+		return posts, fmt.Errorf("subreddit.GetPostsAfterTimeout: %w", err)
+	}
+	return posts, nil
+}
+
+// GetInfo fetches the subreddit's metadata such as its description, subscriber count and NSFW flag.
+// It returns an error wrapping ErrNotFound if the subreddit does not exist and ErrForbidden if it is private.
+func (s *Subreddit) GetInfo(ctx context.Context) (*SubredditInfo, error) {
+	info, err := s.client.getSubredditInfo(ctx, s.Name)
+	if err != nil {
+		return nil, fmt.Errorf("subreddit.GetInfo: %w", err)
+	}
+	return info, nil
+}
+
+// GetRandomPost fetches a single random post from the subreddit. It returns an error wrapping
+// ErrNotFound if the subreddit has no posts.
+func (s *Subreddit) GetRandomPost(ctx context.Context) (*Post, error) {
+	post, err := s.client.getRandomPost(ctx, s.Name)
+	if err != nil {
+		return nil, fmt.Errorf("subreddit.GetRandomPost: %w", err)
+	}
+	return post, nil
+}
+
+// GetRules fetches the subreddit's rules in priority order. It returns an error wrapping
+// ErrNotFound if the subreddit does not exist and ErrForbidden if it is private.
+func (s *Subreddit) GetRules(ctx context.Context) ([]Rule, error) {
+	rules, err := s.client.getRules(ctx, s.Name)
+	if err != nil {
+		return nil, fmt.Errorf("subreddit.GetRules: %w", err)
+	}
+	return rules, nil
+}
+
+// GetModerators fetches the subreddit's moderators, with each moderator's permissions. It
+// returns an error wrapping ErrNotFound if the subreddit does not exist and ErrForbidden if the
+// token may not read the list, such as for a private subreddit.
+func (s *Subreddit) GetModerators(ctx context.Context) ([]UserEntry, error) {
+	users, err := s.client.getUserList(ctx, s.Name, "moderators")
+	if err != nil {
+		return users, fmt.Errorf("subreddit.GetModerators: %w", err)
+	}
+	return users, nil
+}
+
+// GetContributors fetches the subreddit's approved users, following pagination until the list is
+// exhausted. Reddit only shows the list to its moderators, so it requires user context
+// authentication (see NewAuthWithRefreshToken) as a moderator with the read scope; other
+// tokens get an error wrapping ErrForbidden.
+func (s *Subreddit) GetContributors(ctx context.Context) ([]UserEntry, error) {
+	users, err := s.client.getUserList(ctx, s.Name, "contributors")
+	if err != nil {
+		return users, fmt.Errorf("subreddit.GetContributors: %w", err)
+	}
+	return users, nil
+}
+
+// GetWidgets fetches the subreddit's sidebar and topbar widgets, such as its rules, related
+// communities and custom text. Subreddits without widgets return an empty Widgets.
+func (s *Subreddit) GetWidgets(ctx context.Context) (*Widgets, error) {
+	widgets, err := s.client.getWidgets(ctx, s.Name)
+	if err != nil {
+		return nil, fmt.Errorf("subreddit.GetWidgets: %w", err)
+	}
+	return widgets, nil
+}
+
+// Submit creates a post in the subreddit on behalf of the authenticated user and returns it.
+// Use WithSelfText for a text post or WithURL for a link post. It requires user context
+// authentication (see NewAuthWithRefreshToken) with the submit scope. Reddit's submission
+// rate limit is reported as an error wrapping ErrRateLimited, and other rejections as an
+// error wrapping ErrBadRequest.
+func (s *Subreddit) Submit(ctx context.Context, title string, opts ...SubmitOption) (*Post, error) {
+	post, err := s.client.submit(ctx, s.Name, title, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("subreddit.Submit: %w", err)
+	}
+	return post, nil
+}
+
+// Subscribe subscribes the authenticated user to the subreddit. It requires user context
+// authentication (see NewAuthWithRefreshToken) with the subscribe scope; app-only tokens
+// get an error wrapping ErrForbidden.
+func (s *Subreddit) Subscribe(ctx context.Context) error {
+	if err := setSubscription(ctx, s.client, s.Name, true); err != nil {
+		return fmt.Errorf("subreddit.Subscribe: %w", err)
+	}
+	return nil
+}
+
+// Unsubscribe unsubscribes the authenticated user from the subreddit. Like Subscribe, it
+// requires user context authentication with the subscribe scope.
+func (s *Subreddit) Unsubscribe(ctx context.Context) error {
+	if err := setSubscription(ctx, s.client, s.Name, false); err != nil {
+		return fmt.Errorf("subreddit.Unsubscribe: %w", err)
+	}
+	return nil
 }
 
 // String returns a string representation of the Subreddit struct
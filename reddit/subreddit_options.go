@@ -8,7 +8,52 @@ import (
 // SubredditOption is a function type for modifying subreddit request parameters
 type SubredditOption func(params map[string]string)
 
-// WithSort returns a SubredditOption that sets the sort order
+// PostSort represents the sort order of a subreddit listing
+type PostSort string
+
+const (
+	SortHot           PostSort = "hot"
+	SortNew           PostSort = "new"
+	SortTop           PostSort = "top"
+	SortRising        PostSort = "rising"
+	SortControversial PostSort = "controversial"
+)
+
+// Valid returns an error if the sort order is not one supported by Reddit
+func (s PostSort) Valid() error {
+	switch s {
+	case SortHot, SortNew, SortTop, SortRising, SortControversial:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidSort, string(s))
+	}
+}
+
+// Timeframe represents the time window used by the top and controversial listings
+type Timeframe string
+
+const (
+	TimeframeHour  Timeframe = "hour"
+	TimeframeDay   Timeframe = "day"
+	TimeframeWeek  Timeframe = "week"
+	TimeframeMonth Timeframe = "month"
+	TimeframeYear  Timeframe = "year"
+	TimeframeAll   Timeframe = "all"
+)
+
+// Valid returns an error if the timeframe is not one supported by Reddit
+func (t Timeframe) Valid() error {
+	switch t {
+	case TimeframeHour, TimeframeDay, TimeframeWeek, TimeframeMonth, TimeframeYear, TimeframeAll:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidTimeframe, string(t))
+	}
+}
+
+// WithSort returns a SubredditOption that sets the sort order.
+// The value is validated against the PostSort constants when the request is made, and posts are
+// then fetched from the sort's own listing, such as /r/{subreddit}/new.json.
 func WithSort(sort string) SubredditOption {
 	return func(params map[string]string) {
 		if sort != "" {
@@ -17,6 +62,42 @@ func WithSort(sort string) SubredditOption {
 	}
 }
 
+// WithTimeframe returns a SubredditOption that sets the time window for top and controversial listings
+func WithTimeframe(timeframe Timeframe) SubredditOption {
+	return func(params map[string]string) {
+		if timeframe != "" {
+			params["t"] = string(timeframe)
+		}
+	}
+}
+
+// WithSubredditDetail returns a SubredditOption that asks Reddit to attach the subreddit's
+// details to each post, exposed as Post.SubredditInfo, saving a separate GetInfo call
+func WithSubredditDetail() SubredditOption {
+	return func(params map[string]string) {
+		params["sr_detail"] = "1"
+	}
+}
+
+// WithIncludeNSFW returns a SubredditOption that asks Reddit to include NSFW posts in the listing.
+// The authenticated account must also have over_18 enabled, otherwise NSFW subreddits are refused
+// with an error wrapping ErrNSFWGate.
+func WithIncludeNSFW() SubredditOption {
+	return func(params map[string]string) {
+		params["include_over_18"] = "on"
+	}
+}
+
+// WithGeoFilter returns a SubredditOption that sets the g parameter, restricting the hot listing
+// to posts popular in a region given as a country code such as "GB", or "GLOBAL" for everywhere
+func WithGeoFilter(region string) SubredditOption {
+	return func(params map[string]string) {
+		if region != "" {
+			params["g"] = region
+		}
+	}
+}
+
 // WithLimit returns a SubredditOption that sets the limit parameter
 func WithSubredditLimit(limit int) SubredditOption {
 	return func(params map[string]string) {
@@ -34,3 +115,40 @@ func WithAfterTimestamp(timestamp int64) SubredditOption {
 		}
 	}
 }
+
+// WithAfterCursor returns a SubredditOption that fetches the posts after the given cursor, the
+// fullname of a post such as "t3_abc123", such as the After cursor of a PostPage
+func WithAfterCursor(after string) SubredditOption {
+	return func(params map[string]string) {
+		if after != "" {
+			params["after"] = after
+		}
+	}
+}
+
+// WithBeforeCursor returns a SubredditOption that fetches the posts before the given cursor, the
+// fullname of a post such as "t3_abc123", moving towards newer posts. It takes precedence over
+// any after cursor, so it pairs with the before cursor returned by GetPostsPage to page backwards.
+func WithBeforeCursor(before string) SubredditOption {
+	return func(params map[string]string) {
+		if before != "" {
+			params["before"] = before
+		}
+	}
+}
+
+// validateSubredditParams checks the sort and timeframe parameters so that invalid
+// values are reported before any request is made
+func validateSubredditParams(params map[string]string) error {
+	if sort, ok := params["sort"]; ok {
+		if err := PostSort(sort).Valid(); err != nil {
+			return err
+		}
+	}
+	if timeframe, ok := params["t"]; ok {
+		if err := Timeframe(timeframe).Valid(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
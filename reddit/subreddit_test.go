@@ -3,7 +3,11 @@ package reddit_test
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/JohnPlummer/reddit-client/reddit"
@@ -81,63 +85,1232 @@ var _ = Describe("Subreddit", func() {
 		})
 	})
 
+	Describe("NewMultiSubreddit", func() {
+		It("fetches the combined subreddits in one request", func() {
+			transport.AddResponse("/r/golang+rust.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"title": "Go Post", "subreddit": "golang", "id": "go1"}},
+						map[string]any{"data": map[string]any{"title": "Rust Post", "subreddit": "rust", "id": "rs1"}},
+					},
+				},
+			}))
+
+			multi, err := reddit.NewMultiSubreddit([]string{"golang", " rust "}, client)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(multi.Name).To(Equal("golang+rust"))
+
+			posts, err := multi.GetPosts(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(2))
+			Expect(posts[0].Subreddit).To(Equal("golang"))
+			Expect(posts[1].Subreddit).To(Equal("rust"))
+			Expect(transport.GetCallHistory()).To(ContainElement(HavePrefix("/r/golang+rust.json")))
+		})
+
+		It("rejects an empty list of names", func() {
+			multi, err := reddit.NewMultiSubreddit(nil, client)
+			Expect(errors.Is(err, reddit.ErrInvalidSubredditName)).To(BeTrue())
+			Expect(multi).To(BeNil())
+		})
+
+		It("rejects blank names", func() {
+			multi, err := reddit.NewMultiSubreddit([]string{"golang", ""}, client)
+			Expect(errors.Is(err, reddit.ErrInvalidSubredditName)).To(BeTrue())
+			Expect(multi).To(BeNil())
+		})
+
+		It("rejects names with illegal characters", func() {
+			multi, err := reddit.NewMultiSubreddit([]string{"golang", "rust+zig"}, client)
+			Expect(errors.Is(err, reddit.ErrInvalidSubredditName)).To(BeTrue())
+			Expect(multi).To(BeNil())
+		})
+	})
+
+	Describe("name validation", func() {
+		It("accepts valid names", func() {
+			for _, name := range []string{"golang", "Go_Lang", "a", "AskReddit2", "abcdefghijklmnopqrstu"} {
+				transport.AddResponse("/r/"+name+".json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{"children": []any{}},
+				}))
+
+				_, err := reddit.NewSubreddit(name, client).GetPosts(ctx)
+				Expect(err).NotTo(HaveOccurred(), name)
+			}
+		})
+
+		It("rejects invalid names without making a request", func() {
+			transport.Reset()
+			for _, name := range []string{"", "go lang", "golang/new", "golang?x=1", "r/golang", "abcdefghijklmnopqrstuv", "golang+"} {
+				sub := reddit.NewSubreddit(name, client)
+
+				_, err := sub.GetPosts(ctx)
+				Expect(errors.Is(err, reddit.ErrInvalidSubredditName)).To(BeTrue(), name)
+
+				_, err = sub.GetInfo(ctx)
+				Expect(errors.Is(err, reddit.ErrInvalidSubredditName)).To(BeTrue(), name)
+			}
+			Expect(transport.GetCallHistory()).To(BeEmpty())
+		})
+	})
+
 	Describe("GetPosts", func() {
 		BeforeEach(func() {
-			// Mock response for /r/golang.json
+			// Mock responses for /r/golang.json and the sort listings the tests request
+			listing := func() *http.Response {
+				return reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{
+								"data": map[string]any{
+									"title":        "First Post",
+									"selftext":     "Content 1",
+									"url":          "https://example.com/1",
+									"created_utc":  float64(time.Now().Unix()),
+									"subreddit":    "golang",
+									"id":           "post1",
+									"score":        float64(100),
+									"num_comments": float64(10),
+								},
+							},
+							map[string]any{
+								"data": map[string]any{
+									"title":        "Second Post",
+									"selftext":     "Content 2",
+									"url":          "https://example.com/2",
+									"created_utc":  float64(time.Now().Unix()),
+									"subreddit":    "golang",
+									"id":           "post2",
+									"score":        float64(200),
+									"num_comments": float64(20),
+								},
+							},
+						},
+						"after": "t3_post2",
+					},
+				})
+			}
+			for _, path := range []string{"/r/golang.json", "/r/golang/new.json", "/r/golang/top.json"} {
+				transport.AddResponse(path, listing())
+			}
+		})
+
+		It("fetches posts with the specified parameters", func() {
+			posts, err := subreddit.GetPosts(ctx, reddit.WithSort("new"), reddit.WithSubredditLimit(2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(2))
+			Expect(posts[0].Title).To(Equal("First Post"))
+			Expect(posts[1].Title).To(Equal("Second Post"))
+		})
+
+		It("respects the limit", func() {
+			posts, err := subreddit.GetPosts(ctx, reddit.WithSort("new"), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			Expect(posts[0].Title).To(Equal("First Post"))
+		})
+
+		It("applies subreddit options", func() {
+			timestamp := time.Now().Unix()
+			posts, err := subreddit.GetPosts(ctx, reddit.WithSort("new"), reddit.WithSubredditLimit(1), reddit.WithAfterTimestamp(timestamp))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			Expect(posts[0].Title).To(Equal("First Post"))
+		})
+
+		It("requests the sort's own listing and sends the timeframe as a query parameter", func() {
+			_, err := subreddit.GetPosts(ctx, reddit.WithSort("top"), reddit.WithTimeframe(reddit.TimeframeWeek), reddit.WithSubredditLimit(2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transport.GetCallHistory()).To(ContainElement(And(
+				HavePrefix("/r/golang/top.json"),
+				ContainSubstring("t=week"),
+				Not(ContainSubstring("sort=")),
+			)))
+		})
+
+		It("leaves SubredditInfo unset without WithSubredditDetail", func() {
+			posts, err := subreddit.GetPosts(ctx, reddit.WithSubredditLimit(2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(2))
+			Expect(posts[0].SubredditInfo).To(BeNil())
+			Expect(transport.GetCallHistory()).NotTo(ContainElement(ContainSubstring("sr_detail")))
+		})
+
+		It("requests and parses the subreddit detail with WithSubredditDetail", func() {
 			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
 				"data": map[string]any{
 					"children": []any{
 						map[string]any{
 							"data": map[string]any{
-								"title":        "First Post",
-								"selftext":     "Content 1",
-								"url":          "https://example.com/1",
-								"created_utc":  float64(time.Now().Unix()),
-								"subreddit":    "golang",
-								"id":           "post1",
-								"score":        float64(100),
-								"num_comments": float64(10),
+								"title":     "First Post",
+								"subreddit": "golang",
+								"id":        "post1",
+								"sr_detail": map[string]any{
+									"name":               "t5_2rc7j",
+									"display_name":       "golang",
+									"title":              "The Go Programming Language",
+									"public_description": "Ask questions about Go.",
+									"subscribers":        float64(250000),
+									"over_18":            false,
+									"created_utc":        float64(1257454223),
+								},
 							},
 						},
-						map[string]any{
-							"data": map[string]any{
-								"title":        "Second Post",
-								"selftext":     "Content 2",
-								"url":          "https://example.com/2",
-								"created_utc":  float64(time.Now().Unix()),
-								"subreddit":    "golang",
-								"id":           "post2",
-								"score":        float64(200),
-								"num_comments": float64(20),
+					},
+				},
+			}))
+
+			posts, err := subreddit.GetPosts(ctx, reddit.WithSubredditDetail(), reddit.WithSubredditLimit(1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transport.GetCallHistory()).To(ContainElement(And(
+				HavePrefix("/r/golang.json"),
+				ContainSubstring("sr_detail=1"),
+			)))
+			Expect(posts).To(HaveLen(1))
+			Expect(posts[0].SubredditInfo).NotTo(BeNil())
+			Expect(posts[0].SubredditInfo.ID).To(Equal("2rc7j"))
+			Expect(posts[0].SubredditInfo.Name).To(Equal("golang"))
+			Expect(posts[0].SubredditInfo.Subscribers).To(Equal(250000))
+			Expect(posts[0].SubredditInfo.CreatedUTC).To(Equal(int64(1257454223)))
+		})
+
+		Context("with an NSFW subreddit", func() {
+			It("returns ErrNSFWGate when the account has not opted into NSFW content", func() {
+				resp := reddit.CreateJSONResponse(map[string]any{
+					"reason":  "over18",
+					"message": "Forbidden",
+					"error":   403,
+				})
+				resp.StatusCode = http.StatusForbidden
+				transport.AddResponse("/r/golang.json", resp)
+
+				posts, err := subreddit.GetPosts(ctx, reddit.WithIncludeNSFW())
+				Expect(errors.Is(err, reddit.ErrNSFWGate)).To(BeTrue())
+				Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+				Expect(posts).To(BeNil())
+			})
+
+			It("asks Reddit to include NSFW posts", func() {
+				_, err := subreddit.GetPosts(ctx, reddit.WithIncludeNSFW(), reddit.WithSubredditLimit(2))
+				Expect(err).NotTo(HaveOccurred())
+
+				history := transport.GetCallHistory()
+				Expect(history[len(history)-1]).To(ContainSubstring("include_over_18=on"))
+			})
+		})
+
+		It("sends the geo filter with WithGeoFilter", func() {
+			_, err := subreddit.GetPosts(ctx, reddit.WithGeoFilter("GB"), reddit.WithSubredditLimit(2))
+			Expect(err).NotTo(HaveOccurred())
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(ContainSubstring("g=GB"))
+		})
+
+		Context("with invalid options", func() {
+			It("reports an invalid sort before making any request", func() {
+				posts, err := subreddit.GetPosts(ctx, reddit.WithSort("newest"))
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, reddit.ErrInvalidSort)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(`"newest"`))
+				Expect(posts).To(BeNil())
+				Expect(transport.GetCallCount()).To(Equal(0))
+			})
+
+			It("reports an invalid timeframe before making any request", func() {
+				posts, err := subreddit.GetPosts(ctx, reddit.WithSort("top"), reddit.WithTimeframe("fortnight"))
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, reddit.ErrInvalidTimeframe)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(`"fortnight"`))
+				Expect(posts).To(BeNil())
+				Expect(transport.GetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("sorted listings", func() {
+		listing := func(id string) *http.Response {
+			return reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": id, "title": "Sorted Post"}},
+					},
+					"after": nil,
+				},
+			})
+		}
+
+		It("GetHotPosts fetches from /r/golang/hot.json", func() {
+			transport.AddResponse("/r/golang/hot.json", listing("sorted1"))
+
+			posts, err := subreddit.GetHotPosts(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			Expect(posts[0].ID).To(Equal("sorted1"))
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(HavePrefix("/r/golang/hot.json?"))
+		})
+
+		It("GetNewPosts fetches from /r/golang/new.json", func() {
+			transport.AddResponse("/r/golang/new.json", listing("sorted1"))
+
+			posts, err := subreddit.GetNewPosts(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			Expect(posts[0].ID).To(Equal("sorted1"))
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(HavePrefix("/r/golang/new.json?"))
+		})
+
+		It("GetTopPosts fetches from /r/golang/top.json", func() {
+			transport.AddResponse("/r/golang/top.json", listing("sorted1"))
+
+			posts, err := subreddit.GetTopPosts(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			Expect(posts[0].ID).To(Equal("sorted1"))
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(HavePrefix("/r/golang/top.json?"))
+		})
+
+		It("GetRisingPosts fetches from /r/golang/rising.json", func() {
+			transport.AddResponse("/r/golang/rising.json", listing("sorted1"))
+
+			posts, err := subreddit.GetRisingPosts(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			Expect(posts[0].ID).To(Equal("sorted1"))
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(HavePrefix("/r/golang/rising.json?"))
+		})
+
+		It("passes the other options through and ignores WithSort", func() {
+			transport.AddResponse("/r/golang/top.json", listing("top1"))
+
+			posts, err := subreddit.GetTopPosts(ctx,
+				reddit.WithSort("sideways"),
+				reddit.WithTimeframe(reddit.TimeframeWeek),
+				reddit.WithSubredditLimit(5),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+
+			history := transport.GetCallHistory()
+			call := history[len(history)-1]
+			Expect(call).To(HavePrefix("/r/golang/top.json?"))
+			Expect(call).To(ContainSubstring("t=week"))
+			Expect(call).To(ContainSubstring("limit=5"))
+			Expect(call).NotTo(ContainSubstring("sort="))
+		})
+
+		It("reports an invalid timeframe before making any request", func() {
+			calls := transport.GetCallCount()
+			posts, err := subreddit.GetTopPosts(ctx, reddit.WithTimeframe("fortnight"))
+			Expect(errors.Is(err, reddit.ErrInvalidTimeframe)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("subreddit.GetTopPosts"))
+			Expect(posts).To(BeNil())
+			Expect(transport.GetCallCount()).To(Equal(calls))
+		})
+	})
+
+	Describe("GetPostsWithCursor", func() {
+		It("returns the after token of the last non-empty page", func() {
+			transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "post1", "title": "First Post"}},
+						map[string]any{"data": map[string]any{"id": "post2", "title": "Second Post"}},
+					},
+					"after": "t3_post2",
+				},
+			}))
+			transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}, "after": nil},
+			}))
+
+			page, err := subreddit.GetPostsWithCursor(ctx, reddit.WithSubredditLimit(10))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page.Posts).To(HaveLen(2))
+			Expect(page.After).To(Equal("t3_post2"))
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(ContainSubstring("after=t3_post2"))
+		})
+
+		It("returns an empty cursor once the listing is exhausted, as GetPostsFromCursor does", func() {
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "post3", "title": "Last Post"}},
+					},
+					"after": nil,
+				},
+			}))
+
+			page, err := subreddit.GetPostsWithCursor(ctx, reddit.WithAfterCursor("t3_post2"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page.Posts).To(HaveLen(1))
+			Expect(page.After).To(BeEmpty())
+		})
+
+		It("resumes from a cursor and keeps it when nothing new is found", func() {
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}, "after": nil},
+			}))
+
+			page, err := subreddit.GetPostsWithCursor(ctx, reddit.WithAfterCursor("t3_post2"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page.Posts).To(BeEmpty())
+			Expect(page.After).To(Equal("t3_post2"))
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(ContainSubstring("after=t3_post2"))
+		})
+
+		It("requests the sort's own listing", func() {
+			transport.AddResponse("/r/golang/top.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": []any{}, "after": nil},
+			}))
+
+			_, err := subreddit.GetPostsWithCursor(ctx, reddit.WithSort("top"))
+			Expect(err).NotTo(HaveOccurred())
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(HavePrefix("/r/golang/top.json"))
+			Expect(history[len(history)-1]).NotTo(ContainSubstring("sort="))
+		})
+
+		It("reports invalid options before making any request", func() {
+			calls := transport.GetCallCount()
+			page, err := subreddit.GetPostsWithCursor(ctx, reddit.WithSort("sideways"))
+			Expect(errors.Is(err, reddit.ErrInvalidSort)).To(BeTrue())
+			Expect(page).To(BeNil())
+			Expect(transport.GetCallCount()).To(Equal(calls))
+		})
+	})
+
+	Describe("GetPostsPage", func() {
+		BeforeEach(func() {
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "post3", "title": "Third Post"}},
+						map[string]any{"data": map[string]any{"id": "post4", "title": "Fourth Post"}},
+					},
+					"before": "t3_post3",
+					"after":  "t3_post4",
+				},
+			}))
+		})
+
+		It("returns the page with both cursors", func() {
+			posts, before, after, err := subreddit.GetPostsPage(ctx, "t3_post2", reddit.WithSubredditLimit(2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(2))
+			Expect(posts[0].ID).To(Equal("post3"))
+			Expect(before).To(Equal("t3_post3"))
+			Expect(after).To(Equal("t3_post4"))
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(ContainSubstring("after=t3_post2"))
+			Expect(history[len(history)-1]).To(ContainSubstring("limit=2"))
+		})
+
+		It("requests the sort's own listing", func() {
+			transport.AddResponse("/r/golang/new.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{map[string]any{"data": map[string]any{"id": "post5"}}},
+				},
+			}))
+
+			posts, _, _, err := subreddit.GetPostsPage(ctx, "", reddit.WithSort("new"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(HavePrefix("/r/golang/new.json"))
+			Expect(history[len(history)-1]).NotTo(ContainSubstring("sort="))
+		})
+
+		It("returns empty cursors when Reddit reports no neighbouring pages", func() {
+			transport.AddResponse("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{map[string]any{"data": map[string]any{"id": "post1"}}},
+					"before":   nil,
+					"after":    nil,
+				},
+			}))
+
+			posts, before, after, err := subreddit.GetPostsPage(ctx, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(1))
+			Expect(before).To(BeEmpty())
+			Expect(after).To(BeEmpty())
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).NotTo(ContainSubstring("after="))
+		})
+
+		It("pages backwards with WithBeforeCursor", func() {
+			_, _, _, err := subreddit.GetPostsPage(ctx, "t3_post2", reddit.WithBeforeCursor("t3_post3"))
+			Expect(err).NotTo(HaveOccurred())
+
+			history := transport.GetCallHistory()
+			Expect(history[len(history)-1]).To(ContainSubstring("before=t3_post3"))
+			Expect(history[len(history)-1]).NotTo(ContainSubstring("after="))
+		})
+
+		It("reports invalid options before making a request", func() {
+			transport.Reset()
+
+			_, _, _, err := subreddit.GetPostsPage(ctx, "", reddit.WithSort("sideways"))
+			Expect(errors.Is(err, reddit.ErrInvalidSort)).To(BeTrue())
+			Expect(transport.GetCallHistory()).To(BeEmpty())
+		})
+	})
+
+	Describe("GetInfo", func() {
+		It("parses the subreddit metadata", func() {
+			transport.AddResponse("/r/golang/about.json", reddit.CreateJSONResponse(map[string]any{
+				"kind": "t5",
+				"data": map[string]any{
+					"id":                 "2rc7j",
+					"display_name":       "golang",
+					"title":              "The Go Programming Language",
+					"public_description": "Ask questions and post articles about the Go programming language.",
+					"subscribers":        float64(250000),
+					"active_user_count":  float64(420),
+					"over18":             false,
+					"created_utc":        float64(1258657432),
+				},
+			}))
+
+			info, err := subreddit.GetInfo(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.ID).To(Equal("2rc7j"))
+			Expect(info.Fullname()).To(Equal("t5_2rc7j"))
+			Expect(info.Name).To(Equal("golang"))
+			Expect(info.Title).To(Equal("The Go Programming Language"))
+			Expect(info.PublicDescription).To(HavePrefix("Ask questions"))
+			Expect(info.Subscribers).To(Equal(250000))
+			Expect(info.ActiveUserCount).To(Equal(420))
+			Expect(info.Over18).To(BeFalse())
+			Expect(info.CreatedUTC).To(Equal(int64(1258657432)))
+		})
+
+		It("returns ErrNotFound for a missing subreddit", func() {
+			transport.AddResponse("/r/golang/about.json", &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader(`{"message": "Not Found", "error": 404}`)),
+			})
+
+			info, err := subreddit.GetInfo(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, reddit.ErrNotFound)).To(BeTrue())
+			Expect(info).To(BeNil())
+		})
+
+		It("returns ErrNotFound when Reddit answers with a search listing", func() {
+			transport.AddResponse("/r/golang/about.json", reddit.CreateJSONResponse(map[string]any{
+				"kind": "Listing",
+				"data": map[string]any{"children": []any{}},
+			}))
+
+			info, err := subreddit.GetInfo(ctx)
+			Expect(errors.Is(err, reddit.ErrNotFound)).To(BeTrue())
+			Expect(info).To(BeNil())
+		})
+
+		It("returns ErrForbidden for a private subreddit", func() {
+			transport.AddResponse("/r/golang/about.json", &http.Response{
+				StatusCode: http.StatusForbidden,
+				Body:       io.NopCloser(strings.NewReader(`{"reason": "private", "message": "Forbidden", "error": 403}`)),
+			})
+
+			info, err := subreddit.GetInfo(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+			Expect(info).To(BeNil())
+		})
+	})
+
+	Describe("GetRandomPost", func() {
+		It("parses the post from the comments page pair", func() {
+			transport.AddResponse("/r/golang/random", reddit.CreateJSONResponse([]any{
+				map[string]any{
+					"kind": "Listing",
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{
+								"kind": "t3",
+								"data": map[string]any{
+									"title":     "Random Post",
+									"subreddit": "golang",
+									"id":        "rand1",
+									"score":     float64(42),
+								},
 							},
 						},
 					},
-					"after": "t3_post2",
+				},
+				map[string]any{
+					"kind": "Listing",
+					"data": map[string]any{"children": []any{}},
 				},
 			}))
+
+			post, err := subreddit.GetRandomPost(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(post.ID).To(Equal("rand1"))
+			Expect(post.Title).To(Equal("Random Post"))
+			Expect(post.RedditScore).To(Equal(42))
 		})
 
-		It("fetches posts with the specified parameters", func() {
-			posts, err := subreddit.GetPosts(ctx, reddit.WithSort("new"), reddit.WithSubredditLimit(2))
+		It("returns ErrNotFound for a subreddit without posts", func() {
+			transport.AddResponse("/r/golang/random", reddit.CreateJSONResponse(map[string]any{
+				"kind": "Listing",
+				"data": map[string]any{"children": []any{}},
+			}))
+
+			post, err := subreddit.GetRandomPost(ctx)
+			Expect(errors.Is(err, reddit.ErrNotFound)).To(BeTrue())
+			Expect(post).To(BeNil())
+		})
+	})
+
+	Describe("GetRules", func() {
+		It("parses the rules array", func() {
+			transport.AddResponse("/r/golang/about/rules.json", &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`{
+					"rules": [
+						{"kind": "all", "short_name": "Be civil", "description": "No personal attacks.", "violation_reason": "Incivility", "priority": 0, "created_utc": 1600000000.0},
+						{"kind": "link", "short_name": "Go content only", "description": "Posts must be about Go.", "priority": 1}
+					],
+					"site_rules": ["Spam"]
+				}`)),
+			})
+
+			rules, err := subreddit.GetRules(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(Equal([]reddit.Rule{
+				{ShortName: "Be civil", Description: "No personal attacks.", Kind: "all", Priority: 0},
+				{ShortName: "Go content only", Description: "Posts must be about Go.", Kind: "link", Priority: 1},
+			}))
+		})
+
+		It("returns an empty slice for a subreddit without rules", func() {
+			transport.AddResponse("/r/golang/about/rules.json", reddit.CreateJSONResponse(map[string]any{
+				"rules":      []any{},
+				"site_rules": []any{"Spam"},
+			}))
+
+			rules, err := subreddit.GetRules(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(BeEmpty())
+		})
+
+		It("returns ErrNotFound when Reddit answers with a search listing", func() {
+			transport.AddResponse("/r/golang/about/rules.json", reddit.CreateJSONResponse(map[string]any{
+				"kind": "Listing",
+				"data": map[string]any{"children": []any{}},
+			}))
+
+			rules, err := subreddit.GetRules(ctx)
+			Expect(errors.Is(err, reddit.ErrNotFound)).To(BeTrue())
+			Expect(rules).To(BeNil())
+		})
+
+		It("returns ErrForbidden for a private subreddit", func() {
+			transport.AddResponse("/r/golang/about/rules.json", &http.Response{
+				StatusCode: http.StatusForbidden,
+				Body:       io.NopCloser(strings.NewReader(`{"reason": "private", "message": "Forbidden", "error": 403}`)),
+			})
+
+			rules, err := subreddit.GetRules(ctx)
+			Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+			Expect(rules).To(BeNil())
+		})
+	})
+
+	Describe("GetModerators and GetContributors", func() {
+		It("parses the moderator listing with permissions", func() {
+			transport.AddResponse("/r/golang/about/moderators.json", &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`{
+					"kind": "UserList",
+					"data": {"children": [
+						{"name": "gopher", "id": "t2_abc", "date": 1500000000.0, "mod_permissions": ["all"]},
+						{"name": "helper", "id": "t2_def", "date": 1600000000.0, "mod_permissions": ["posts", "wiki"]}
+					]}
+				}`)),
+			})
+
+			moderators, err := subreddit.GetModerators(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moderators).To(Equal([]reddit.UserEntry{
+				{Name: "gopher", ID: "t2_abc", Permissions: []string{"all"}},
+				{Name: "helper", ID: "t2_def", Permissions: []string{"posts", "wiki"}},
+			}))
+		})
+
+		It("follows pagination through the contributor list", func() {
+			transport.AddResponseToQueue("/r/golang/about/contributors.json", reddit.CreateJSONResponse(map[string]any{
+				"kind": "UserList",
+				"data": map[string]any{
+					"children": []any{map[string]any{"name": "first", "id": "t2_1"}},
+					"after":    "rel_1",
+				},
+			}))
+			transport.AddResponseToQueue("/r/golang/about/contributors.json", reddit.CreateJSONResponse(map[string]any{
+				"kind": "UserList",
+				"data": map[string]any{
+					"children": []any{map[string]any{"name": "second", "id": "t2_2"}},
+					"after":    nil,
+				},
+			}))
+
+			contributors, err := subreddit.GetContributors(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(contributors).To(Equal([]reddit.UserEntry{
+				{Name: "first", ID: "t2_1"},
+				{Name: "second", ID: "t2_2"},
+			}))
+
+			var calls []string
+			for _, call := range transport.GetCallHistory() {
+				if strings.HasPrefix(call, "/r/golang/about/contributors.json") {
+					calls = append(calls, call)
+				}
+			}
+			Expect(calls).To(HaveLen(2))
+			Expect(calls[1]).To(ContainSubstring("after=rel_1"))
+		})
+
+		It("returns ErrForbidden when the token may not read the list", func() {
+			transport.AddResponse("/r/golang/about/contributors.json", &http.Response{
+				StatusCode: http.StatusForbidden,
+				Body:       io.NopCloser(strings.NewReader(`{"message": "Forbidden", "error": 403}`)),
+			})
+
+			contributors, err := subreddit.GetContributors(ctx)
+			Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+			Expect(contributors).To(BeNil())
+		})
+
+		It("returns ErrNotFound when Reddit answers with a search listing", func() {
+			transport.AddResponse("/r/golang/about/moderators.json", reddit.CreateJSONResponse(map[string]any{
+				"kind": "Listing",
+				"data": map[string]any{"children": []any{}},
+			}))
+
+			moderators, err := subreddit.GetModerators(ctx)
+			Expect(errors.Is(err, reddit.ErrNotFound)).To(BeTrue())
+			Expect(moderators).To(BeNil())
+		})
+	})
+
+	Describe("GetWidgets", func() {
+		const widgetsFixture = `{
+			"items": {
+				"widget_rules": {
+					"id": "widget_rules",
+					"kind": "subreddit-rules",
+					"shortName": "Rules",
+					"data": [
+						{"shortName": "Be civil", "description": "No personal attacks.", "violationReason": "Incivility", "priority": 0},
+						{"shortName": "Stay on topic", "description": "Posts must be about Go.", "violationReason": "Off-topic", "priority": 1}
+					]
+				},
+				"widget_related": {
+					"id": "widget_related",
+					"kind": "community-list",
+					"shortName": "Related",
+					"data": [{"name": "rust", "subscribers": 300000, "isNSFW": false}]
+				},
+				"widget_about": {
+					"id": "widget_about",
+					"kind": "textarea",
+					"shortName": "About",
+					"text": "Welcome to **r/golang**"
+				},
+				"widget_calendar": {
+					"id": "widget_calendar",
+					"kind": "calendar",
+					"shortName": "Events",
+					"configuration": {"numEvents": 5}
+				},
+				"widget_links": {
+					"id": "widget_links",
+					"kind": "button",
+					"shortName": "Links",
+					"description": "Useful links",
+					"buttons": [{"kind": "text", "text": "Go Tour", "url": "https://go.dev/tour"}]
+				}
+			},
+			"layout": {
+				"sidebar": {"order": ["widget_about", "widget_rules", "widget_related", "widget_calendar"]},
+				"topbar": {"order": ["widget_links"]}
+			}
+		}`
+
+		It("parses the common widget kinds in layout order", func() {
+			transport.AddResponse("/r/golang/api/widgets", &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(widgetsFixture)),
+			})
+
+			widgets, err := subreddit.GetWidgets(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(widgets.Sidebar).To(HaveLen(4))
+
+			about := widgets.Sidebar[0]
+			Expect(about.Kind).To(Equal(reddit.WidgetKindTextArea))
+			Expect(about.ShortName).To(Equal("About"))
+			Expect(about.Text).To(Equal("Welcome to **r/golang**"))
+
+			rules := widgets.Sidebar[1]
+			Expect(rules.Kind).To(Equal(reddit.WidgetKindRules))
+			Expect(rules.Rules).To(HaveLen(2))
+			Expect(rules.Rules[1].ShortName).To(Equal("Stay on topic"))
+			Expect(rules.Rules[1].ViolationReason).To(Equal("Off-topic"))
+			Expect(rules.Rules[1].Priority).To(Equal(1))
+
+			related := widgets.Sidebar[2]
+			Expect(related.Communities).To(ConsistOf(reddit.WidgetCommunity{Name: "rust", Subscribers: 300000}))
+
+			Expect(widgets.Topbar).To(HaveLen(1))
+			Expect(widgets.Topbar[0].Description).To(Equal("Useful links"))
+			Expect(widgets.Topbar[0].Buttons).To(ConsistOf(reddit.WidgetButton{Kind: "text", Text: "Go Tour", URL: "https://go.dev/tour"}))
+		})
+
+		It("preserves the raw JSON of widget kinds without a typed model", func() {
+			transport.AddResponse("/r/golang/api/widgets", &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(widgetsFixture)),
+			})
+
+			widgets, err := subreddit.GetWidgets(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			calendar := widgets.Sidebar[3]
+			Expect(calendar.Kind).To(Equal("calendar"))
+			Expect(calendar.ID).To(Equal("widget_calendar"))
+			Expect(string(calendar.Raw)).To(ContainSubstring(`"numEvents": 5`))
+		})
+
+		It("returns empty widgets for a subreddit without widgets", func() {
+			transport.AddResponse("/r/golang/api/widgets", reddit.CreateJSONResponse(map[string]any{
+				"items":  map[string]any{},
+				"layout": map[string]any{},
+			}))
+
+			widgets, err := subreddit.GetWidgets(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(widgets.Sidebar).To(BeEmpty())
+			Expect(widgets.Topbar).To(BeEmpty())
+		})
+
+		It("returns ErrNotFound for an unknown subreddit", func() {
+			transport.AddResponse("/r/golang/api/widgets", &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody})
+
+			_, err := subreddit.GetWidgets(ctx)
+			Expect(errors.Is(err, reddit.ErrNotFound)).To(BeTrue())
+		})
+	})
+
+	Describe("Submit", func() {
+		var forms []url.Values
+
+		BeforeEach(func() {
+			forms = nil
+			auth, err := reddit.NewAuth("test_client_id", "test_client_secret",
+				reddit.WithAuthTransport(transport))
+			Expect(err).NotTo(HaveOccurred())
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithRequestInterceptor(captureForms("/api/submit", &forms)),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+		})
+
+		submitResponse := func(errs []any, data map[string]any) *http.Response {
+			result := map[string]any{"errors": errs}
+			if data != nil {
+				result["data"] = data
+			}
+			return reddit.CreateJSONResponse(map[string]any{"json": result})
+		}
+
+		It("submits a self post", func() {
+			transport.AddResponse("/api/submit", submitResponse([]any{}, map[string]any{
+				"id":   "abc123",
+				"name": "t3_abc123",
+				"url":  "https://www.reddit.com/r/golang/comments/abc123/hello/",
+			}))
+
+			post, err := subreddit.Submit(ctx, "Hello", reddit.WithSelfText("Hello, Gophers"))
 			Expect(err).NotTo(HaveOccurred())
+			Expect(post.ID).To(Equal("abc123"))
+			Expect(post.Fullname()).To(Equal("t3_abc123"))
+			Expect(post.Title).To(Equal("Hello"))
+			Expect(post.SelfText).To(Equal("Hello, Gophers"))
+			Expect(post.URL).To(Equal("https://www.reddit.com/r/golang/comments/abc123/hello/"))
+
+			Expect(forms).To(HaveLen(1))
+			Expect(forms[0].Get("kind")).To(Equal("self"))
+			Expect(forms[0].Get("sr")).To(Equal("golang"))
+			Expect(forms[0].Get("title")).To(Equal("Hello"))
+			Expect(forms[0].Get("text")).To(Equal("Hello, Gophers"))
+			Expect(forms[0].Get("api_type")).To(Equal("json"))
+		})
+
+		It("submits a link post", func() {
+			transport.AddResponse("/api/submit", submitResponse([]any{}, map[string]any{
+				"id":  "def456",
+				"url": "https://www.reddit.com/r/golang/comments/def456/go_123/",
+			}))
+
+			post, err := subreddit.Submit(ctx, "Go 1.23", reddit.WithURL("https://go.dev/blog/go1.23"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(post.ID).To(Equal("def456"))
+			Expect(post.URL).To(Equal("https://go.dev/blog/go1.23"))
+
+			Expect(forms).To(HaveLen(1))
+			Expect(forms[0].Get("kind")).To(Equal("link"))
+			Expect(forms[0].Get("url")).To(Equal("https://go.dev/blog/go1.23"))
+			Expect(forms[0].Has("text")).To(BeFalse())
+		})
+
+		It("translates a RATELIMIT error into ErrRateLimited", func() {
+			transport.AddResponse("/api/submit", submitResponse([]any{
+				[]any{"RATELIMIT", "you are doing that too much. try again in 5 minutes.", "ratelimit"},
+			}, nil))
+
+			post, err := subreddit.Submit(ctx, "Hello", reddit.WithSelfText("Hello"))
+			Expect(errors.Is(err, reddit.ErrRateLimited)).To(BeTrue())
+			Expect(reddit.IsRateLimitError(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("try again in 5 minutes"))
+			Expect(post).To(BeNil())
+
+			var apiErr *reddit.APIError
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.Reason).To(Equal("RATELIMIT"))
+		})
+
+		It("translates a SUBREDDIT_NOEXIST error into ErrNotFound", func() {
+			transport.AddResponse("/api/submit", submitResponse([]any{
+				[]any{"SUBREDDIT_NOEXIST", "that subreddit doesn't exist", "sr"},
+			}, nil))
+
+			_, err := subreddit.Submit(ctx, "Hello", reddit.WithSelfText("Hello"))
+			Expect(errors.Is(err, reddit.ErrNotFound)).To(BeTrue())
+			Expect(reddit.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("reports other submission errors as ErrBadRequest", func() {
+			transport.AddResponse("/api/submit", submitResponse([]any{
+				[]any{"SUBREDDIT_NOTALLOWED", "you aren't allowed to post there.", "sr"},
+			}, nil))
+
+			_, err := subreddit.Submit(ctx, "Hello", reddit.WithSelfText("Hello"))
+			Expect(errors.Is(err, reddit.ErrBadRequest)).To(BeTrue())
+			Expect(reddit.IsBadRequestError(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("SUBREDDIT_NOTALLOWED"))
+		})
+
+		It("rejects a post with both self text and a URL before making a request", func() {
+			_, err := subreddit.Submit(ctx, "Hello", reddit.WithSelfText("Hello"), reddit.WithURL("https://go.dev"))
+			Expect(errors.Is(err, reddit.ErrBadRequest)).To(BeTrue())
+			Expect(forms).To(BeEmpty())
+		})
+	})
+
+	Describe("Subscribe and Unsubscribe", func() {
+		var forms []url.Values
+
+		BeforeEach(func() {
+			forms = nil
+			auth, err := reddit.NewAuth("test_client_id", "test_client_secret",
+				reddit.WithAuthTransport(transport))
+			Expect(err).NotTo(HaveOccurred())
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithRequestInterceptor(captureForms("/api/subscribe", &forms)),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+		})
+
+		It("subscribes to the subreddit", func() {
+			transport.AddResponse("/api/subscribe", reddit.CreateJSONResponse(map[string]any{}))
+
+			Expect(subreddit.Subscribe(ctx)).To(Succeed())
+			Expect(forms).To(HaveLen(1))
+			Expect(forms[0].Get("action")).To(Equal("sub"))
+			Expect(forms[0].Get("sr_name")).To(Equal("golang"))
+		})
+
+		It("unsubscribes from the subreddit", func() {
+			transport.AddResponse("/api/subscribe", reddit.CreateJSONResponse(map[string]any{}))
+
+			Expect(subreddit.Unsubscribe(ctx)).To(Succeed())
+			Expect(forms).To(HaveLen(1))
+			Expect(forms[0].Get("action")).To(Equal("unsub"))
+			Expect(forms[0].Get("sr_name")).To(Equal("golang"))
+		})
+
+		It("subscribes to every subreddit of a combined subreddit", func() {
+			transport.AddResponse("/api/subscribe", reddit.CreateJSONResponse(map[string]any{}))
+			multi, err := reddit.NewMultiSubreddit([]string{"golang", "rust"}, client)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(multi.Subscribe(ctx)).To(Succeed())
+			Expect(forms).To(HaveLen(1))
+			Expect(forms[0].Get("action")).To(Equal("sub"))
+			Expect(forms[0].Get("sr_name")).To(Equal("golang,rust"))
+		})
+
+		It("surfaces ErrForbidden for app-only tokens", func() {
+			transport.AddResponse("/api/subscribe", &http.Response{StatusCode: http.StatusForbidden, Body: http.NoBody})
+
+			err := subreddit.Subscribe(ctx)
+			Expect(errors.Is(err, reddit.ErrForbidden)).To(BeTrue())
+		})
+	})
+
+	Describe("GetPostsAfterTimeout", func() {
+		It("returns the posts collected before the deadline", func() {
+			// Let the first page through and hang on the second until the deadline
+			mockClient.Transport = &blockingTransport{
+				next: transport,
+				block: func(req *http.Request) bool {
+					return req.URL.Query().Get("after") == "t3_post2"
+				},
+			}
+
+			posts, err := subreddit.GetPostsAfterTimeout(ctx, nil, 0, 200*time.Millisecond)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
 			Expect(posts).To(HaveLen(2))
-			Expect(posts[0].Title).To(Equal("First Post"))
-			Expect(posts[1].Title).To(Equal("Second Post"))
+			Expect(posts[0].ID).To(Equal("post1"))
+			Expect(posts[1].ID).To(Equal("post2"))
+		})
+
+		It("returns promptly with the posts collected when a retry would outlast the deadline", func() {
+			transport.Reset()
+			transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "post1", "subreddit": "golang"}},
+					},
+					"after": "t3_post1",
+				},
+			}))
+			transport.AddResponseToQueue("/r/golang.json", &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Body:       http.NoBody,
+				Header:     make(http.Header),
+			})
+
+			retrying, err := reddit.NewClient(client.Auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithRetries(2),
+				reddit.WithRetryDelay(time.Minute),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			start := time.Now()
+			posts, err := reddit.NewSubreddit("golang", retrying).GetPostsAfterTimeout(ctx, nil, 0, time.Second)
+			Expect(time.Since(start)).To(BeNumerically("<", 500*time.Millisecond))
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(posts).To(HaveLen(1))
+		})
+
+		It("returns all posts without error when the crawl completes in time", func() {
+			posts, err := subreddit.GetPostsAfterTimeout(ctx, nil, 2, time.Second)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(2))
+		})
+	})
+
+	Describe("cancellation during GetPostsAfter", func() {
+		var deadlineCtx context.Context
+
+		BeforeEach(func() {
+			// Let the first page through and hang on the second until the deadline
+			mockClient.Transport = &blockingTransport{
+				next: transport,
+				block: func(req *http.Request) bool {
+					return req.URL.Query().Get("after") == "t3_post2"
+				},
+			}
+
+			var cancel context.CancelFunc
+			deadlineCtx, cancel = context.WithTimeout(ctx, 200*time.Millisecond)
+			DeferCleanup(cancel)
+		})
+
+		It("returns the posts collected so far by default", func() {
+			posts, err := subreddit.GetPostsAfter(deadlineCtx, nil, 0)
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(posts).To(HaveLen(2))
+		})
+
+		It("returns no posts when partial results are disabled", func() {
+			allOrNothing, err := reddit.NewClient(client.Auth,
+				reddit.WithHTTPClient(mockClient),
+				reddit.WithPartialResultsOnCancel(false),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			posts, err := reddit.NewSubreddit("golang", allOrNothing).GetPostsAfter(deadlineCtx, nil, 0)
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(posts).To(BeNil())
+		})
+	})
+
+	Describe("StreamPosts", func() {
+		BeforeEach(func() {
+			transport.Reset()
+			transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "post1", "title": "First Post"}},
+						map[string]any{"data": map[string]any{"id": "post2", "title": "Second Post"}},
+					},
+					"after": "t3_post2",
+				},
+			}))
+			transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{
+					"children": []any{
+						map[string]any{"data": map[string]any{"id": "post3", "title": "Third Post"}},
+					},
+					"after": "",
+				},
+			}))
+		})
+
+		It("emits posts from every page in order and closes the channels", func() {
+			posts, errs := subreddit.StreamPosts(ctx, reddit.StreamOptions{})
+
+			var ids []string
+			for post := range posts {
+				ids = append(ids, post.ID)
+			}
+
+			Expect(ids).To(Equal([]string{"post1", "post2", "post3"}))
+			Expect(<-errs).To(BeNil())
+		})
+
+		It("stops and closes the channels when the context is cancelled", func() {
+			cancelCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			posts, errs := subreddit.StreamPosts(cancelCtx, reddit.StreamOptions{})
+			first := <-posts
+			Expect(first.ID).To(Equal("post1"))
+
+			cancel()
+
+			Eventually(posts).Should(BeClosed())
+			Expect(<-errs).To(MatchError(context.Canceled))
+		})
+
+		It("buffers the post channel with BufferSize", func() {
+			posts, errs := subreddit.StreamPosts(ctx, reddit.StreamOptions{BufferSize: 10})
+			Expect(cap(posts)).To(Equal(10))
+
+			for range posts {
+			}
+			Expect(<-errs).To(BeNil())
+			Expect(transport.GetCallHistory()).To(ContainElement("/r/golang.json?limit=100&raw_json=1"))
+		})
+
+		It("keeps polling for newer posts with PollInterval until the context is cancelled", func() {
+			// The new listing serves the existing posts, then a poll that finds two new posts,
+			// and later polls find none
+			transport.Reset()
+			transport.AddResponseWithFallback("/r/golang/new.json",
+				reddit.CreateJSONResponse(map[string]any{"data": map[string]any{"children": []any{}}}),
+				reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{"data": map[string]any{"id": "post1", "title": "First Post"}},
+							map[string]any{"data": map[string]any{"id": "post2", "title": "Second Post"}},
+							map[string]any{"data": map[string]any{"id": "post3", "title": "Third Post"}},
+						},
+					},
+				}),
+				reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{"data": map[string]any{"id": "post5", "title": "Fifth Post"}},
+							map[string]any{"data": map[string]any{"id": "post4", "title": "Fourth Post"}},
+						},
+					},
+				}),
+			)
+			cancelCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			posts, errs := subreddit.StreamPosts(cancelCtx, reddit.StreamOptions{PollInterval: 5 * time.Millisecond}, reddit.WithSort("new"))
+
+			var ids []string
+			for len(ids) < 5 {
+				var post reddit.Post
+				Eventually(posts).Should(Receive(&post))
+				ids = append(ids, post.ID)
+			}
+			Expect(ids).To(Equal([]string{"post1", "post2", "post3", "post4", "post5"}))
+
+			cancel()
+			Eventually(posts).Should(BeClosed())
+			Expect(<-errs).To(MatchError(context.Canceled))
+			history := transport.GetCallHistory()
+			Expect(history).To(ContainElement("/r/golang/new.json?limit=100&raw_json=1"))
+			Expect(history).To(ContainElement("/r/golang/new.json?before=t3_post1&limit=100&raw_json=1"))
+			Expect(history).NotTo(ContainElement(ContainSubstring("/r/golang.json")))
+		})
+
+		It("reports invalid options without making any request", func() {
+			posts, errs := subreddit.StreamPosts(ctx, reddit.StreamOptions{}, reddit.WithSort("best"))
+
+			Eventually(posts).Should(BeClosed())
+			Expect(errors.Is(<-errs, reddit.ErrInvalidSort)).To(BeTrue())
+			Expect(transport.GetCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("PostSort", func() {
+		It("accepts every supported sort order", func() {
+			for _, sort := range []reddit.PostSort{reddit.SortHot, reddit.SortNew, reddit.SortTop, reddit.SortRising, reddit.SortControversial} {
+				Expect(sort.Valid()).To(Succeed())
+			}
+		})
+
+		It("rejects unknown sort orders", func() {
+			err := reddit.PostSort("hottest").Valid()
+			Expect(errors.Is(err, reddit.ErrInvalidSort)).To(BeTrue())
 		})
+	})
 
-		It("respects the limit", func() {
-			posts, err := subreddit.GetPosts(ctx, reddit.WithSort("new"), reddit.WithSubredditLimit(1))
-			Expect(err).NotTo(HaveOccurred())
-			Expect(posts).To(HaveLen(1))
-			Expect(posts[0].Title).To(Equal("First Post"))
+	Describe("Timeframe", func() {
+		It("accepts every supported timeframe", func() {
+			for _, timeframe := range []reddit.Timeframe{reddit.TimeframeHour, reddit.TimeframeDay, reddit.TimeframeWeek, reddit.TimeframeMonth, reddit.TimeframeYear, reddit.TimeframeAll} {
+				Expect(timeframe.Valid()).To(Succeed())
+			}
 		})
 
-		It("applies subreddit options", func() {
-			timestamp := time.Now().Unix()
-			posts, err := subreddit.GetPosts(ctx, reddit.WithSort("new"), reddit.WithSubredditLimit(1), reddit.WithAfterTimestamp(timestamp))
-			Expect(err).NotTo(HaveOccurred())
-			Expect(posts).To(HaveLen(1))
-			Expect(posts[0].Title).To(Equal("First Post"))
+		It("rejects unknown timeframes", func() {
+			err := reddit.Timeframe("decade").Valid()
+			Expect(errors.Is(err, reddit.ErrInvalidTimeframe)).To(BeTrue())
 		})
 	})
 
@@ -205,6 +1378,32 @@ var _ = Describe("Subreddit", func() {
 			Expect(posts).To(HaveLen(0))
 		})
 
+		It("sends the number of posts already fetched as count", func() {
+			paged := newPagedListingTransport(3, 100)
+			auth, err := reddit.NewAuth("test_client_id", "test_client_secret", reddit.WithAuthTransport(paged))
+			Expect(err).NotTo(HaveOccurred())
+
+			var queries []url.Values
+			client, err = reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: paged}),
+				reddit.WithRequestInterceptor(func(req *http.Request) error {
+					queries = append(queries, req.URL.Query())
+					return nil
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			posts, err := reddit.NewSubreddit("golang", client).GetPostsAfter(ctx, nil, 300)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(300))
+
+			Expect(queries).To(HaveLen(3))
+			Expect(queries[0].Has("count")).To(BeFalse())
+			Expect(queries[1].Get("count")).To(Equal("100"))
+			Expect(queries[1].Get("after")).To(Equal("t3_p0_99"))
+			Expect(queries[2].Get("count")).To(Equal("200"))
+		})
+
 		Context("GetPostsAfter edge cases", func() {
 			BeforeEach(func() {
 				transport.Reset()
@@ -453,6 +1652,38 @@ var _ = Describe("Subreddit", func() {
 				Expect(posts).To(BeNil())
 			})
 
+			It("returns the posts fetched before a mid-pagination error with WithPartialResultsOnError", func() {
+				auth, err := reddit.NewAuth("test_client_id", "test_client_secret", reddit.WithAuthTransport(transport))
+				Expect(err).NotTo(HaveOccurred())
+				client, err = reddit.NewClient(auth,
+					reddit.WithHTTPClient(&http.Client{Transport: transport}),
+					reddit.WithNoRetries(),
+					reddit.WithPartialResultsOnError(),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				subreddit = reddit.NewSubreddit("golang", client)
+
+				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{"data": map[string]any{"id": "post1", "title": "First Post", "subreddit": "golang"}},
+						},
+						"after": "t3_post1",
+					},
+				}))
+				transport.AddResponseToQueue("/r/golang.json", &http.Response{
+					StatusCode: http.StatusBadGateway,
+					Header:     make(http.Header),
+					Body:       http.NoBody,
+				})
+
+				posts, err := subreddit.GetPostsAfter(ctx, nil, 5)
+				Expect(err).To(HaveOccurred())
+				Expect(reddit.IsServerError(err)).To(BeTrue())
+				Expect(posts).To(HaveLen(1))
+				Expect(posts[0].ID).To(Equal("post1"))
+			})
+
 			It("handles very large limit values", func() {
 				// Setup single post
 				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
@@ -609,7 +1840,7 @@ var _ = Describe("Subreddit", func() {
 				afterPost := &reddit.Post{ID: "post0"}
 				posts, err := subreddit.GetPostsAfter(ctx, afterPost, 5)
 				Expect(err).NotTo(HaveOccurred())
-				// Should include all duplicates as returned by API (client doesn't deduplicate)
+				// Should include all duplicates as returned by API (client doesn't deduplicate by default)
 				Expect(posts).To(HaveLen(3))
 				Expect(posts[0].ID).To(Equal("post1"))
 				Expect(posts[1].ID).To(Equal("post1"))
@@ -620,6 +1851,70 @@ var _ = Describe("Subreddit", func() {
 				Expect(posts[2].Title).To(Equal("First Post Again"))
 			})
 
+			It("returns each post once with WithDeduplication", func() {
+				// First page with duplicated post in API response
+				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{
+								"data": map[string]any{
+									"title":        "First Post",
+									"selftext":     "Content 1",
+									"url":          "https://example.com/1",
+									"created_utc":  float64(time.Now().Unix()),
+									"subreddit":    "golang",
+									"id":           "post1",
+									"score":        float64(100),
+									"num_comments": float64(10),
+								},
+							},
+							map[string]any{
+								"data": map[string]any{
+									"title":        "First Post Duplicate",
+									"selftext":     "Content 1 duplicate",
+									"url":          "https://example.com/1-dup",
+									"created_utc":  float64(time.Now().Unix()),
+									"subreddit":    "golang",
+									"id":           "post1", // Duplicate ID
+									"score":        float64(101),
+									"num_comments": float64(11),
+								},
+							},
+						},
+						"after": "t3_post1",
+					},
+				}))
+
+				// Second page returns the same post again
+				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{
+						"children": []any{
+							map[string]any{
+								"data": map[string]any{
+									"title":        "First Post Again",
+									"selftext":     "Content 1 again",
+									"url":          "https://example.com/1-again",
+									"created_utc":  float64(time.Now().Unix()),
+									"subreddit":    "golang",
+									"id":           "post1", // Same ID again
+									"score":        float64(102),
+									"num_comments": float64(12),
+								},
+							},
+						},
+						"after": "",
+					},
+				}))
+
+				afterPost := &reddit.Post{ID: "post0"}
+				posts, err := subreddit.GetPostsAfter(ctx, afterPost, 5, reddit.WithDeduplication())
+				Expect(err).NotTo(HaveOccurred())
+				// Only the first occurrence is kept
+				Expect(posts).To(HaveLen(1))
+				Expect(posts[0].ID).To(Equal("post1"))
+				Expect(posts[0].Title).To(Equal("First Post"))
+			})
+
 			It("handles pagination call count verification", func() {
 				// First page
 				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
@@ -678,5 +1973,368 @@ var _ = Describe("Subreddit", func() {
 				Expect(history[2]).To(ContainSubstring("after=t3_post1"))
 			})
 		})
+
+		Context("with a post callback", func() {
+			queuePage := func(after string, ids ...string) {
+				children := make([]any, 0, len(ids))
+				for _, id := range ids {
+					children = append(children, map[string]any{
+						"data": map[string]any{"id": id, "title": "Post " + id, "subreddit": "golang"},
+					})
+				}
+				transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{"children": children, "after": after},
+				}))
+			}
+
+			BeforeEach(func() {
+				transport.Reset()
+			})
+
+			It("cannot be passed to calls that would ignore it", func() {
+				// GetPosts and the comment calls take options of a different type, so passing
+				// WithPostCallback or WithDeduplication to them does not compile
+				for _, option := range []reddit.PostOption{reddit.WithPostCallback(nil), reddit.WithDeduplication()} {
+					optionType := reflect.TypeOf(option)
+					for _, unsupported := range []reflect.Type{
+						reflect.TypeOf(reddit.SubredditOption(nil)),
+						reflect.TypeOf(reddit.CommentOption(nil)),
+					} {
+						Expect(optionType.AssignableTo(unsupported)).To(BeFalse())
+						Expect(optionType.ConvertibleTo(unsupported)).To(BeFalse())
+					}
+				}
+			})
+
+			It("requests the listing of a sort set by a caller-defined option", func() {
+				transport.AddResponseToQueue("/r/golang/new.json", reddit.CreateJSONResponse(map[string]any{
+					"data": map[string]any{"children": []any{}, "after": ""},
+				}))
+
+				withNew := func(req *reddit.PostRequest) { req.Params["sort"] = "new" }
+				_, err := subreddit.GetPostsAfter(ctx, nil, 0, withNew)
+				Expect(err).NotTo(HaveOccurred())
+
+				history := transport.GetCallHistory()
+				Expect(history[len(history)-1]).To(HavePrefix("/r/golang/new.json"))
+				Expect(history[len(history)-1]).NotTo(ContainSubstring("sort="))
+			})
+
+			It("still accepts PostOptions defined by callers alongside the callback", func() {
+				queuePage("", "post1")
+
+				withFlair := func(req *reddit.PostRequest) { req.Params["flair"] = "News" }
+				var seen []string
+				_, err := subreddit.GetPostsAfter(ctx, nil, 0, withFlair, reddit.WithPostCallback(func(post reddit.Post) error {
+					seen = append(seen, post.ID)
+					return nil
+				}))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(seen).To(Equal([]string{"post1"}))
+				Expect(transport.GetCallHistory()).To(ContainElement(ContainSubstring("flair=News")))
+			})
+
+			It("calls the callback for every post in order and still returns them", func() {
+				queuePage("t3_post2", "post1", "post2")
+				queuePage("", "post3")
+
+				var seen []string
+				posts, err := subreddit.GetPostsAfter(ctx, nil, 0, reddit.WithPostCallback(func(post reddit.Post) error {
+					seen = append(seen, post.ID)
+					return nil
+				}))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(seen).To(Equal([]string{"post1", "post2", "post3"}))
+				Expect(posts).To(HaveLen(3))
+			})
+
+			It("does not call the callback for posts beyond the limit", func() {
+				queuePage("t3_post3", "post1", "post2", "post3")
+
+				var seen []string
+				posts, err := subreddit.GetPostsAfter(ctx, nil, 2, reddit.WithPostCallback(func(post reddit.Post) error {
+					seen = append(seen, post.ID)
+					return nil
+				}))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(seen).To(Equal([]string{"post1", "post2"}))
+				Expect(posts).To(HaveLen(2))
+			})
+
+			It("stops pagination when the callback returns an error", func() {
+				queuePage("t3_post2", "post1", "post2")
+				queuePage("t3_post4", "post3", "post4")
+				queuePage("", "post5")
+
+				stop := errors.New("stop")
+				var seen []string
+				posts, err := subreddit.GetPostsAfter(ctx, nil, 0, reddit.WithPostCallback(func(post reddit.Post) error {
+					seen = append(seen, post.ID)
+					if post.ID == "post3" {
+						return stop
+					}
+					return nil
+				}))
+				Expect(err).To(MatchError(stop))
+				Expect(seen).To(Equal([]string{"post1", "post2", "post3"}))
+				Expect(posts).To(HaveLen(2))
+				Expect(posts[1].ID).To(Equal("post2"))
+
+				// Auth plus two listing pages; the third page is never fetched
+				Expect(transport.GetCallCount()).To(Equal(3))
+			})
+		})
+	})
+
+	Describe("GetPostsFromCursor", func() {
+		var (
+			queries   []url.Values
+			subreddit *reddit.Subreddit
+		)
+
+		BeforeEach(func() {
+			queries = nil
+			paged := newPagedListingTransport(3, 100)
+			auth, err := reddit.NewAuth("test_client_id", "test_client_secret", reddit.WithAuthTransport(paged))
+			Expect(err).NotTo(HaveOccurred())
+
+			client, err := reddit.NewClient(auth,
+				reddit.WithHTTPClient(&http.Client{Transport: paged}),
+				reddit.WithRequestInterceptor(func(req *http.Request) error {
+					queries = append(queries, req.URL.Query())
+					return nil
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			subreddit = reddit.NewSubreddit("golang", client)
+		})
+
+		It("starts pagination at the given cursor", func() {
+			result, err := subreddit.GetPostsFromCursor(ctx, "t3_p0_99", reddit.WithLimit(100))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Items).To(HaveLen(100))
+			Expect(result.Items[0].ID).To(Equal("p1_0"))
+
+			Expect(queries).To(HaveLen(1))
+			Expect(queries[0].Get("after")).To(Equal("t3_p0_99"))
+		})
+
+		It("returns the cursor to resume from", func() {
+			result, err := subreddit.GetPostsFromCursor(ctx, "", reddit.WithLimit(100))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.After).To(Equal("t3_p0_99"))
+
+			resumed, err := subreddit.GetPostsFromCursor(ctx, result.After, reddit.WithLimit(100))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resumed.Items[0].ID).To(Equal("p1_0"))
+			Expect(resumed.After).To(Equal("t3_p1_99"))
+		})
+
+		It("returns the last returned post as the cursor when the limit ends mid-page", func() {
+			result, err := subreddit.GetPostsFromCursor(ctx, "", reddit.WithLimit(150))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Items).To(HaveLen(150))
+			Expect(result.After).To(Equal("t3_p1_49"))
+		})
+
+		It("returns an empty cursor once the listing is exhausted", func() {
+			result, err := subreddit.GetPostsFromCursor(ctx, "t3_p0_99", reddit.WithLimit(500))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Items).To(HaveLen(200))
+			Expect(result.After).To(BeEmpty())
+		})
+
+		It("keeps the given cursor when the first page fails", func() {
+			result, err := subreddit.GetPostsFromCursor(ctx, "t3_unknown")
+			Expect(err).To(HaveOccurred())
+			Expect(result.Items).To(BeEmpty())
+			Expect(result.After).To(Equal("t3_unknown"))
+		})
+	})
+
+	Describe("GetPostsBefore", func() {
+		// queuePage queues a listing page; before pagination ignores the after token
+		queuePage := func(ids ...string) {
+			children := make([]any, 0, len(ids))
+			for _, id := range ids {
+				children = append(children, map[string]any{
+					"data": map[string]any{"id": id, "title": "Post " + id, "subreddit": "golang"},
+				})
+			}
+			transport.AddResponseToQueue("/r/golang.json", reddit.CreateJSONResponse(map[string]any{
+				"data": map[string]any{"children": children, "after": ""},
+			}))
+		}
+
+		BeforeEach(func() {
+			transport.Reset()
+		})
+
+		It("fetches posts before the specified post", func() {
+			queuePage("post3", "post4")
+			queuePage()
+
+			posts, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post5"}, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(2))
+			Expect(posts[0].ID).To(Equal("post3"))
+			Expect(posts[1].ID).To(Equal("post4"))
+
+			history := transport.GetCallHistory()
+			Expect(history[1]).To(ContainSubstring("before=t3_post5"))
+			Expect(history[1]).NotTo(ContainSubstring("after="))
+		})
+
+		It("uses the first post of each page as the next cursor", func() {
+			queuePage("post5", "post6")
+			queuePage("post3", "post4")
+			queuePage()
+
+			posts, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post7"}, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(posts).To(HaveLen(4))
+			Expect(posts[0].ID).To(Equal("post5"))
+			Expect(posts[2].ID).To(Equal("post3"))
+
+			history := transport.GetCallHistory()
+			Expect(history).To(HaveLen(4))
+			Expect(history[2]).To(ContainSubstring("before=t3_post5"))
+			Expect(history[3]).To(ContainSubstring("before=t3_post3"))
+		})
+
+		It("takes precedence over an after option", func() {
+			queuePage("post3")
+			queuePage()
+
+			_, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post4"}, 0, reddit.WithAfter(&reddit.Post{ID: "post1"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transport.GetCallHistory()[1]).NotTo(ContainSubstring("after="))
+		})
+
+		Context("GetPostsBefore edge cases", func() {
+			It("handles pagination with nil before parameter", func() {
+				queuePage("post1", "post2")
+
+				posts, err := subreddit.GetPostsBefore(ctx, nil, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(2))
+				Expect(posts[0].ID).To(Equal("post1"))
+				Expect(posts[1].ID).To(Equal("post2"))
+				Expect(transport.GetCallHistory()[1]).NotTo(ContainSubstring("before="))
+			})
+
+			It("respects exact limit with pagination", func() {
+				queuePage("post3", "post4")
+				queuePage("post1", "post2")
+
+				posts, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post5"}, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(3))
+				Expect(posts[0].ID).To(Equal("post3"))
+				Expect(posts[1].ID).To(Equal("post4"))
+				Expect(posts[2].ID).To(Equal("post1"))
+			})
+
+			It("handles over limit pagination", func() {
+				queuePage("post1")
+				queuePage()
+
+				posts, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post2"}, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(1)) // Should only return what's available
+				Expect(posts[0].ID).To(Equal("post1"))
+			})
+
+			It("handles under limit pagination", func() {
+				queuePage("post1", "post2", "post3")
+
+				posts, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post4"}, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(2))
+				Expect(posts[0].ID).To(Equal("post1"))
+				Expect(posts[1].ID).To(Equal("post2"))
+
+				// The limit was reached on the first page, so no further page is requested
+				Expect(transport.GetCallCount()).To(Equal(2))
+			})
+
+			It("handles network errors mid-pagination", func() {
+				queuePage("post1")
+				transport.SetErrorOnCall(3, errors.New("network timeout")) // Call 3 because call 1 is auth, call 2 is first page
+
+				posts, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post2"}, 5)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("network timeout"))
+				Expect(posts).To(BeNil())
+			})
+
+			It("handles very large limit values", func() {
+				queuePage("post1")
+				queuePage()
+
+				posts, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post2"}, 1000000)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(1))
+				Expect(posts[0].ID).To(Equal("post1"))
+			})
+
+			It("handles zero limit (fetch all)", func() {
+				queuePage("post2")
+				queuePage("post1")
+				queuePage()
+
+				posts, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post3"}, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(2))
+				Expect(posts[0].ID).To(Equal("post2"))
+				Expect(posts[1].ID).To(Equal("post1"))
+			})
+
+			It("verifies proper handling of duplicate items", func() {
+				queuePage("post1", "post1")
+				queuePage("post1")
+				queuePage()
+
+				posts, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post2"}, 5)
+				Expect(err).NotTo(HaveOccurred())
+				// Should include all duplicates as returned by API (client doesn't deduplicate by default)
+				Expect(posts).To(HaveLen(3))
+				for _, post := range posts {
+					Expect(post.ID).To(Equal("post1"))
+				}
+			})
+
+			It("handles pagination call count verification", func() {
+				queuePage("post2")
+				queuePage("post1")
+				queuePage()
+
+				posts, err := subreddit.GetPostsBefore(ctx, &reddit.Post{ID: "post3"}, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(posts).To(HaveLen(2))
+
+				// Should make 4 calls: 1 for auth, 3 for API requests (the last one finds no newer posts)
+				Expect(transport.GetCallCount()).To(Equal(4))
+				history := transport.GetCallHistory()
+				Expect(history[1]).To(ContainSubstring("before=t3_post3"))
+				Expect(history[2]).To(ContainSubstring("before=t3_post2"))
+				Expect(history[3]).To(ContainSubstring("before=t3_post1"))
+			})
+		})
 	})
 })
+
+// blockingTransport delegates to the wrapped transport but blocks requests matching
+// the block predicate until their context is done
+type blockingTransport struct {
+	next  http.RoundTripper
+	block func(req *http.Request) bool
+}
+
+func (b *blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if b.block(req) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}
+	return b.next.RoundTrip(req)
+}
@@ -1,8 +1,12 @@
 package reddit
 
 import (
+	"encoding/json"
 	"fmt"
+	"html"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // getStringField safely extracts a string field from a map with optional default value
@@ -30,6 +34,10 @@ func getFloat64Field(data map[string]any, key string, defaultValue ...float64) f
 			return float64(v)
 		case int64:
 			return float64(v)
+		case json.Number:
+			if parsed, err := v.Float64(); err == nil {
+				return parsed
+			}
 		case string:
 			// Attempt to parse string as float64
 			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
@@ -58,6 +66,10 @@ func getBoolField(data map[string]any, key string, defaultValue ...bool) bool {
 			return v != 0
 		case float64:
 			return v != 0
+		case json.Number:
+			if parsed, err := v.Float64(); err == nil {
+				return parsed != 0
+			}
 		}
 	}
 	if len(defaultValue) > 0 {
@@ -68,20 +80,51 @@ func getBoolField(data map[string]any, key string, defaultValue ...bool) bool {
 
 // getIntField safely extracts an int field from a map with optional default value and validation
 func getIntField(data map[string]any, key string, defaultValue ...int) int {
-	floatValue := getFloat64Field(data, key)
-	if floatValue == 0.0 && len(defaultValue) > 0 {
+	intValue := parseInt64Value(data[key])
+	if intValue == 0 && len(defaultValue) > 0 {
 		return defaultValue[0]
 	}
-	return int(floatValue)
+	return int(intValue)
 }
 
 // getInt64Field safely extracts an int64 field from a map with optional default value
 func getInt64Field(data map[string]any, key string, defaultValue ...int64) int64 {
-	floatValue := getFloat64Field(data, key)
-	if floatValue == 0.0 && len(defaultValue) > 0 {
+	intValue := parseInt64Value(data[key])
+	if intValue == 0 && len(defaultValue) > 0 {
 		return defaultValue[0]
 	}
-	return int64(floatValue)
+	return intValue
+}
+
+// parseInt64Value converts a decoded JSON value to an int64. Integers decoded as json.Number
+// (see WithJSONNumberMode) are converted exactly rather than through float64, which cannot
+// represent integers above 2^53.
+func parseInt64Value(value any) int64 {
+	switch v := value.(type) {
+	case json.Number:
+		if parsed, err := v.Int64(); err == nil {
+			return parsed
+		}
+		if parsed, err := v.Float64(); err == nil {
+			return int64(parsed)
+		}
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case float64:
+		return int64(v)
+	case float32:
+		return int64(v)
+	case string:
+		if parsed, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return parsed
+		}
+		if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+			return int64(parsed)
+		}
+	}
+	return 0
 }
 
 // getValidatedIntField safely extracts an int field with validation (e.g., non-negative scores)
@@ -115,19 +158,89 @@ func parsePostData(data map[string]any) (Post, error) {
 	score := getIntField(data, "score")
 	commentCount := getValidatedIntField(data, "num_comments", func(v int) bool { return v >= 0 }, 0)
 
+	// Author, flair, flags and media; link_flair_text is null for posts without flair
+	author := getStringField(data, "author")
+	permalink := getStringField(data, "permalink")
+	isSelf := getBoolField(data, "is_self")
+	over18 := getBoolField(data, "over_18")
+	spoiler := getBoolField(data, "spoiler")
+	stickied := getBoolField(data, "stickied")
+	linkFlairText := getStringField(data, "link_flair_text")
+	thumbnail := getStringField(data, "thumbnail")
+	upvoteRatio := getFloat64Field(data, "upvote_ratio")
+	gallery := parseGallery(data)
+
+	// Only present when the listing was requested with sr_detail; malformed details are ignored
+	var subredditInfo *SubredditInfo
+	if detail, ok := data["sr_detail"].(map[string]any); ok {
+		if info, err := parseSubredditInfoData(detail); err == nil {
+			subredditInfo = &info
+		}
+	}
+
 	return Post{
-		Title:        title,
-		SelfText:     selfText,
-		URL:          url,
-		Created:      created,
-		Subreddit:    subreddit,
-		ID:           id,
-		RedditScore:  score,
-		ContentScore: 0, // Initialize to 0, will be set by content analysis
-		CommentCount: commentCount,
+		Title:         title,
+		SelfText:      selfText,
+		URL:           url,
+		Created:       created,
+		Subreddit:     subreddit,
+		ID:            id,
+		RedditScore:   score,
+		ContentScore:  0, // Initialize to 0, will be set by content analysis
+		CommentCount:  commentCount,
+		Author:        author,
+		Permalink:     permalink,
+		IsSelf:        isSelf,
+		Over18:        over18,
+		Spoiler:       spoiler,
+		Stickied:      stickied,
+		LinkFlairText: linkFlairText,
+		Thumbnail:     thumbnail,
+		UpvoteRatio:   upvoteRatio,
+		Gallery:       gallery,
+		SubredditInfo: subredditInfo,
 	}, nil
 }
 
+// parseGallery extracts the images of a gallery post, ordering the media_metadata entries by
+// gallery_data. Items whose metadata is missing or not yet processed are skipped. It returns nil
+// for posts that are not galleries.
+func parseGallery(data map[string]any) []MediaItem {
+	galleryData, ok := data["gallery_data"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	items, _ := galleryData["items"].([]any)
+	metadata, _ := data["media_metadata"].(map[string]any)
+
+	var gallery []MediaItem
+	for _, item := range items {
+		itemMap, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		mediaID := getStringField(itemMap, "media_id")
+		media, ok := metadata[mediaID].(map[string]any)
+		if !ok || getStringField(media, "status") != "valid" {
+			continue
+		}
+
+		// s holds the source image; animated images have a gif URL instead of u
+		source, _ := media["s"].(map[string]any)
+		url := getStringField(source, "u", getStringField(source, "gif"))
+
+		gallery = append(gallery, MediaItem{
+			ID:      mediaID,
+			Type:    getStringField(media, "m"),
+			URL:     html.UnescapeString(url), // Reddit HTML-escapes URLs unless raw_json=1 is requested
+			Width:   getIntField(source, "x"),
+			Height:  getIntField(source, "y"),
+			Caption: getStringField(itemMap, "caption"),
+		})
+	}
+	return gallery
+}
+
 // parseCommentData safely extracts comment data from API response using type-safe field extractors
 func parseCommentData(data map[string]any, ingestedAt int64) (Comment, error) {
 	// Validate required fields
@@ -140,12 +253,64 @@ func parseCommentData(data map[string]any, ingestedAt int64) (Comment, error) {
 	author := getStringField(data, "author")
 	body := getStringField(data, "body")
 	created := getInt64Field(data, "created_utc")
+	score := getIntField(data, "score")
+
+	var createdUTC time.Time
+	if created > 0 {
+		createdUTC = time.Unix(created, 0).UTC()
+	}
+
+	// Moderators see an explicit flag, everyone else only sees the placeholder body
+	removed := getBoolField(data, "removed") || body == "[removed]"
+	collapsedReason := getStringField(data, "collapsed_reason")
+
+	// Reddit sends "edited" as false for unedited comments and as a timestamp otherwise,
+	// which the numeric extractor maps to 0 and the timestamp respectively
+	edited := getInt64Field(data, "edited")
+	parentID := getStringField(data, "parent_id")
+	linkID := getStringField(data, "link_id")
+	subreddit := getStringField(data, "subreddit")
 
 	return Comment{
-		Author:     author,
-		Body:       body,
-		Created:    created,
-		ID:         id,
-		IngestedAt: ingestedAt,
+		Author:          author,
+		Body:            body,
+		Created:         created,
+		CreatedUTC:      createdUTC,
+		Score:           score,
+		ID:              id,
+		Removed:         removed,
+		CollapsedReason: collapsedReason,
+		Edited:          edited,
+		ParentID:        parentID,
+		LinkID:          linkID,
+		Subreddit:       subreddit,
+		IngestedAt:      ingestedAt,
+	}, nil
+}
+
+// parseSubredditInfoData safely extracts subreddit metadata from API response using type-safe field extractors
+func parseSubredditInfoData(data map[string]any) (SubredditInfo, error) {
+	// Validate required fields
+	name := getStringField(data, "display_name")
+	if name == "" {
+		return SubredditInfo{}, fmt.Errorf("utils.parseSubredditInfoData: missing required field 'display_name'")
+	}
+
+	// sr_detail omits the id and spells the NSFW flag differently to about.json,
+	// so fall back to the fullname and the alternative spelling
+	id := getStringField(data, "id")
+	if id == "" {
+		id = strings.TrimPrefix(getStringField(data, "name"), "t5_")
+	}
+
+	return SubredditInfo{
+		ID:                id,
+		Name:              name,
+		Title:             getStringField(data, "title"),
+		PublicDescription: getStringField(data, "public_description"),
+		Subscribers:       getValidatedIntField(data, "subscribers", func(v int) bool { return v >= 0 }, 0),
+		ActiveUserCount:   getValidatedIntField(data, "active_user_count", func(v int) bool { return v >= 0 }, 0),
+		Over18:            getBoolField(data, "over18") || getBoolField(data, "over_18"),
+		CreatedUTC:        getInt64Field(data, "created_utc"),
 	}, nil
 }
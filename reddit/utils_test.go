@@ -1,6 +1,9 @@
 package reddit
 
 import (
+	"encoding/json"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -211,6 +214,22 @@ var _ = Describe("Utils", func() {
 			result := getInt64Field(data, "missing_field", int64(456))
 			Expect(result).To(Equal(int64(456)))
 		})
+
+		It("should convert json.Number exactly", func() {
+			data := map[string]any{
+				"test_field": json.Number("9007199254740993"), // 2^53 + 1
+			}
+			result := getInt64Field(data, "test_field")
+			Expect(result).To(Equal(int64(9007199254740993)))
+		})
+
+		It("should truncate a fractional json.Number", func() {
+			data := map[string]any{
+				"test_field": json.Number("1700000000.5"),
+			}
+			result := getInt64Field(data, "test_field")
+			Expect(result).To(Equal(int64(1700000000)))
+		})
 	})
 
 	Describe("getValidatedIntField", func() {
@@ -268,6 +287,44 @@ var _ = Describe("Utils", func() {
 			Expect(post.ContentScore).To(Equal(0))
 		})
 
+		It("should parse author, flair, flags and media fields", func() {
+			data := map[string]any{
+				"id":              "test_id",
+				"author":          "test_user",
+				"permalink":       "/r/test_subreddit/comments/test_id/test_title/",
+				"is_self":         true,
+				"over_18":         true,
+				"spoiler":         true,
+				"stickied":        true,
+				"link_flair_text": "Discussion",
+				"thumbnail":       "https://b.thumbs.redditmedia.com/abc.jpg",
+				"upvote_ratio":    0.87,
+			}
+
+			post, err := parsePostData(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(post.Author).To(Equal("test_user"))
+			Expect(post.Permalink).To(Equal("/r/test_subreddit/comments/test_id/test_title/"))
+			Expect(post.IsSelf).To(BeTrue())
+			Expect(post.Over18).To(BeTrue())
+			Expect(post.Spoiler).To(BeTrue())
+			Expect(post.Stickied).To(BeTrue())
+			Expect(post.LinkFlairText).To(Equal("Discussion"))
+			Expect(post.Thumbnail).To(Equal("https://b.thumbs.redditmedia.com/abc.jpg"))
+			Expect(post.UpvoteRatio).To(Equal(0.87))
+		})
+
+		It("should leave a null flair empty", func() {
+			data := map[string]any{
+				"id":              "test_id",
+				"link_flair_text": nil,
+			}
+
+			post, err := parsePostData(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(post.LinkFlairText).To(BeEmpty())
+		})
+
 		It("should return error for missing ID", func() {
 			data := map[string]any{
 				"title": "Test Title",
@@ -293,6 +350,15 @@ var _ = Describe("Utils", func() {
 			Expect(post.Subreddit).To(Equal(""))
 			Expect(post.RedditScore).To(Equal(0))
 			Expect(post.CommentCount).To(Equal(0))
+			Expect(post.Author).To(Equal(""))
+			Expect(post.Permalink).To(Equal(""))
+			Expect(post.IsSelf).To(BeFalse())
+			Expect(post.Over18).To(BeFalse())
+			Expect(post.Spoiler).To(BeFalse())
+			Expect(post.Stickied).To(BeFalse())
+			Expect(post.LinkFlairText).To(Equal(""))
+			Expect(post.Thumbnail).To(Equal(""))
+			Expect(post.UpvoteRatio).To(Equal(0.0))
 		})
 
 		It("should validate comment count is non-negative", func() {
@@ -307,6 +373,58 @@ var _ = Describe("Utils", func() {
 		})
 	})
 
+	Describe("parseGallery", func() {
+		It("should extract gallery images in gallery order", func() {
+			data := map[string]any{
+				"id":         "gallery_post",
+				"is_gallery": true,
+				"gallery_data": map[string]any{
+					"items": []any{
+						map[string]any{"media_id": "second", "id": 2.0, "caption": "Second upload, shown first"},
+						map[string]any{"media_id": "first", "id": 1.0},
+						map[string]any{"media_id": "animated", "id": 3.0},
+						map[string]any{"media_id": "processing", "id": 4.0},
+					},
+				},
+				"media_metadata": map[string]any{
+					"first": map[string]any{
+						"status": "valid",
+						"e":      "Image",
+						"m":      "image/jpg",
+						"s":      map[string]any{"u": "https://preview.redd.it/first.jpg?width=640&amp;s=abc", "x": 640.0, "y": 480.0},
+					},
+					"second": map[string]any{
+						"status": "valid",
+						"e":      "Image",
+						"m":      "image/png",
+						"s":      map[string]any{"u": "https://preview.redd.it/second.png?width=800&amp;s=def", "x": 800.0, "y": 600.0},
+					},
+					"animated": map[string]any{
+						"status": "valid",
+						"e":      "AnimatedImage",
+						"m":      "image/gif",
+						"s":      map[string]any{"gif": "https://i.redd.it/animated.gif", "mp4": "https://preview.redd.it/animated.gif?format=mp4", "x": 320.0, "y": 240.0},
+					},
+					"processing": map[string]any{"status": "unprocessed"},
+				},
+			}
+
+			post, err := parsePostData(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(post.Gallery).To(Equal([]MediaItem{
+				{ID: "second", Type: "image/png", URL: "https://preview.redd.it/second.png?width=800&s=def", Width: 800, Height: 600, Caption: "Second upload, shown first"},
+				{ID: "first", Type: "image/jpg", URL: "https://preview.redd.it/first.jpg?width=640&s=abc", Width: 640, Height: 480},
+				{ID: "animated", Type: "image/gif", URL: "https://i.redd.it/animated.gif", Width: 320, Height: 240},
+			}))
+		})
+
+		It("should leave the gallery nil for non-gallery posts", func() {
+			post, err := parsePostData(map[string]any{"id": "text_post", "is_self": true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(post.Gallery).To(BeNil())
+		})
+	})
+
 	Describe("parseCommentData", func() {
 		It("should parse valid comment data", func() {
 			data := map[string]any{
@@ -314,12 +432,17 @@ var _ = Describe("Utils", func() {
 				"author":      "test_user",
 				"body":        "Test comment body",
 				"created_utc": 1234567890.0,
+				"parent_id":   "t1_parent",
+				"score":       42.0,
 			}
 			ingestedAt := int64(9876543210)
 
 			comment, err := parseCommentData(data, ingestedAt)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(comment.ID).To(Equal("comment_id"))
+			Expect(comment.ParentID).To(Equal("t1_parent"))
+			Expect(comment.Score).To(Equal(42))
+			Expect(comment.CreatedUTC).To(Equal(time.Unix(1234567890, 0).UTC()))
 			Expect(comment.Author).To(Equal("test_user"))
 			Expect(comment.Body).To(Equal("Test comment body"))
 			Expect(comment.Created).To(Equal(int64(1234567890)))
@@ -350,7 +473,50 @@ var _ = Describe("Utils", func() {
 			Expect(comment.Author).To(Equal(""))
 			Expect(comment.Body).To(Equal(""))
 			Expect(comment.Created).To(Equal(int64(0)))
+			Expect(comment.CreatedUTC.IsZero()).To(BeTrue())
+			Expect(comment.Score).To(Equal(0))
+			Expect(comment.ParentID).To(BeEmpty())
 			Expect(comment.IngestedAt).To(Equal(ingestedAt))
+			Expect(comment.Removed).To(BeFalse())
+			Expect(comment.CollapsedReason).To(BeEmpty())
+			Expect(comment.WasEdited()).To(BeFalse())
+		})
+
+		It("should parse removal and collapse details", func() {
+			data := map[string]any{
+				"id":               "comment_id",
+				"body":             "[removed]",
+				"removed":          true,
+				"collapsed_reason": "comment score below threshold",
+				"edited":           false,
+			}
+
+			comment, err := parseCommentData(data, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comment.Removed).To(BeTrue())
+			Expect(comment.CollapsedReason).To(Equal("comment score below threshold"))
+			Expect(comment.WasEdited()).To(BeFalse())
+		})
+
+		It("should detect removal from the placeholder body", func() {
+			comment, err := parseCommentData(map[string]any{"id": "comment_id", "body": "[removed]"}, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comment.Removed).To(BeTrue())
+		})
+
+		It("should parse the edited timestamp", func() {
+			data := map[string]any{
+				"id":     "comment_id",
+				"body":   "Updated body",
+				"edited": 1700000000.0,
+			}
+
+			comment, err := parseCommentData(data, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comment.WasEdited()).To(BeTrue())
+			editedAt, ok := comment.EditedAt()
+			Expect(ok).To(BeTrue())
+			Expect(editedAt).To(Equal(time.Unix(1700000000, 0).UTC()))
 		})
 	})
 })
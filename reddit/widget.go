@@ -0,0 +1,156 @@
+package reddit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Widget kinds with a typed model. Widgets of other kinds are still returned, with only
+// the common fields and Raw populated.
+const (
+	WidgetKindTextArea      = "textarea"
+	WidgetKindButton        = "button"
+	WidgetKindCommunityList = "community-list"
+	WidgetKindRules         = "subreddit-rules"
+)
+
+// Widgets represents the sidebar and topbar widgets of a subreddit as returned by /r/{name}/api/widgets
+type Widgets struct {
+	Sidebar []Widget // sidebar widgets in display order
+	Topbar  []Widget // topbar widgets (such as menus) in display order
+}
+
+// Widget represents a single subreddit widget. The kind-specific fields are only populated
+// for the kind they belong to; Raw always holds the widget's original JSON.
+type Widget struct {
+	ID        string `json:"id"`
+	Kind      string `json:"kind"`
+	ShortName string `json:"shortName"`
+
+	Text        string            `json:"-"` // textarea: markdown text
+	Buttons     []WidgetButton    `json:"-"` // button: the widget's buttons
+	Description string            `json:"-"` // button: text shown above the buttons
+	Communities []WidgetCommunity `json:"-"` // community-list: the listed subreddits
+	Rules       []WidgetRule      `json:"-"` // subreddit-rules: the subreddit's rules
+
+	Raw json.RawMessage `json:"-"`
+}
+
+// WidgetButton represents a button in a button widget
+type WidgetButton struct {
+	Kind string `json:"kind"`
+	Text string `json:"text"`
+	URL  string `json:"url"`
+}
+
+// WidgetCommunity represents a subreddit listed in a community-list widget
+type WidgetCommunity struct {
+	Name        string `json:"name"`
+	Subscribers int    `json:"subscribers"`
+	Over18      bool   `json:"isNSFW"`
+}
+
+// WidgetRule represents a rule in a subreddit-rules widget
+type WidgetRule struct {
+	ShortName       string `json:"shortName"`
+	Description     string `json:"description"`
+	ViolationReason string `json:"violationReason"`
+	Priority        int    `json:"priority"`
+}
+
+// widgetsResponse mirrors the top level of the /api/widgets response
+type widgetsResponse struct {
+	Items  map[string]json.RawMessage `json:"items"`
+	Layout struct {
+		Sidebar struct {
+			Order []string `json:"order"`
+		} `json:"sidebar"`
+		Topbar struct {
+			Order []string `json:"order"`
+		} `json:"topbar"`
+	} `json:"layout"`
+}
+
+// parseWidgets builds Widgets from the /api/widgets response, ordering widgets by the layout.
+// Widgets referenced by the layout but missing from the items are skipped.
+func parseWidgets(resp widgetsResponse) (*Widgets, error) {
+	widgets := &Widgets{}
+
+	var err error
+	if widgets.Sidebar, err = parseWidgetOrder(resp.Items, resp.Layout.Sidebar.Order); err != nil {
+		return nil, fmt.Errorf("widget.parseWidgets: parsing sidebar failed: %w", err)
+	}
+	if widgets.Topbar, err = parseWidgetOrder(resp.Items, resp.Layout.Topbar.Order); err != nil {
+		return nil, fmt.Errorf("widget.parseWidgets: parsing topbar failed: %w", err)
+	}
+
+	return widgets, nil
+}
+
+// parseWidgetOrder parses the widgets with the given IDs, in order
+func parseWidgetOrder(items map[string]json.RawMessage, order []string) ([]Widget, error) {
+	var widgets []Widget
+	for _, id := range order {
+		raw, ok := items[id]
+		if !ok {
+			continue
+		}
+		widget, err := parseWidget(raw)
+		if err != nil {
+			return nil, fmt.Errorf("widget %s: %w", id, err)
+		}
+		if widget.ID == "" {
+			widget.ID = id
+		}
+		widgets = append(widgets, widget)
+	}
+	return widgets, nil
+}
+
+// parseWidget decodes a single widget, filling in the typed fields for the kinds we model
+func parseWidget(raw json.RawMessage) (Widget, error) {
+	var widget Widget
+	if err := json.Unmarshal(raw, &widget); err != nil {
+		return Widget{}, fmt.Errorf("widget.parseWidget: %w", err)
+	}
+	widget.Raw = raw
+
+	switch widget.Kind {
+	case WidgetKindTextArea:
+		var data struct {
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return Widget{}, fmt.Errorf("widget.parseWidget: decoding %s: %w", widget.Kind, err)
+		}
+		widget.Text = data.Text
+	case WidgetKindButton:
+		var data struct {
+			Description string         `json:"description"`
+			Buttons     []WidgetButton `json:"buttons"`
+		}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return Widget{}, fmt.Errorf("widget.parseWidget: decoding %s: %w", widget.Kind, err)
+		}
+		widget.Description = data.Description
+		widget.Buttons = data.Buttons
+	case WidgetKindCommunityList:
+		var data struct {
+			Data []WidgetCommunity `json:"data"`
+		}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return Widget{}, fmt.Errorf("widget.parseWidget: decoding %s: %w", widget.Kind, err)
+		}
+		widget.Communities = data.Data
+	case WidgetKindRules:
+		var data struct {
+			Data []WidgetRule `json:"data"`
+		}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return Widget{}, fmt.Errorf("widget.parseWidget: decoding %s: %w", widget.Kind, err)
+		}
+		widget.Rules = data.Data
+	}
+
+	return widget, nil
+}